@@ -0,0 +1,210 @@
+// Package certs provides the certificates `eg run --https` serves with: a local
+// certificate authority, made once per user, and a leaf certificate for
+// localhost and any other dev hostnames signed by it.
+package certs
+
+import (
+  "crypto"
+  "crypto/ecdsa"
+  "crypto/elliptic"
+  "crypto/rand"
+  "crypto/tls"
+  "crypto/x509"
+  "crypto/x509/pkix"
+  "encoding/pem"
+  "fmt"
+  "io/ioutil"
+  "math/big"
+  "net"
+  "os"
+  "path/filepath"
+  "time"
+)
+
+// DefaultHosts are always in the leaf certificate.
+var DefaultHosts = []string{"localhost", "127.0.0.1", "::1"}
+
+const (
+  CAFile = "ca.pem"
+  CAKeyFile = "ca-key.pem"
+  CertFile = "cert.pem"
+  KeyFile = "key.pem"
+)
+
+// Dir is where the certificates are kept.
+func Dir() string {
+  home, err := os.UserHomeDir()
+  if err != nil {
+    return ""
+  }
+  return filepath.Join(home, ".config", "eg", "certs")
+}
+
+// Load returns a certificate for hosts and the defaults, signed by the local
+// CA in dir. The CA is created the first time, and the leaf whenever it
+// doesn't cover the hosts or is about to expire. created reports whether the
+// CA is new, in which case it has to be trusted by the browser.
+func Load(dir string, hosts []string) (cert tls.Certificate, created bool, err error) {
+  if err = os.MkdirAll(dir, 0700); err != nil {
+    return
+  }
+  ca, caKey, err := loadPair(filepath.Join(dir, CAFile), filepath.Join(dir, CAKeyFile))
+  if os.IsNotExist(err) {
+    ca, caKey, err = createCA(dir)
+    created = true
+  }
+  if err != nil {
+    return
+  }
+
+  hosts = append(append([]string{}, DefaultHosts...), hosts...)
+  leaf, _, err := loadPair(filepath.Join(dir, CertFile), filepath.Join(dir, KeyFile))
+  if err != nil || !valid(leaf, ca, hosts) {
+    if err = createLeaf(dir, ca, caKey, hosts); err != nil {
+      return
+    }
+  }
+  cert, err = tls.LoadX509KeyPair(filepath.Join(dir, CertFile), filepath.Join(dir, KeyFile))
+  return
+}
+
+// Pool returns the system's trusted certificates along with the local CA in
+// dir, for clients of a proxy serving HTTPS.
+func Pool(dir string) (*x509.CertPool, error) {
+  caPEM, err := ioutil.ReadFile(filepath.Join(dir, CAFile))
+  if err != nil {
+    return nil, err
+  }
+  pool, err := x509.SystemCertPool()
+  if err != nil {
+    pool = x509.NewCertPool()
+  }
+  if !pool.AppendCertsFromPEM(caPEM) {
+    return nil, fmt.Errorf("certs: %v isn't PEM", filepath.Join(dir, CAFile))
+  }
+  return pool, nil
+}
+
+// valid reports whether leaf is signed by ca, covers hosts and lasts at least
+// another day.
+func valid(leaf *x509.Certificate, ca *x509.Certificate, hosts []string) bool {
+  if leaf.CheckSignatureFrom(ca) != nil || time.Now().Add(24 * time.Hour).After(leaf.NotAfter) {
+    return false
+  }
+  for _, h := range hosts {
+    if leaf.VerifyHostname(h) != nil {
+      return false
+    }
+  }
+  return true
+}
+
+func loadPair(certFile string, keyFile string) (*x509.Certificate, crypto.Signer, error) {
+  certPEM, err := ioutil.ReadFile(certFile)
+  if err != nil {
+    return nil, nil, err
+  }
+  keyPEM, err := ioutil.ReadFile(keyFile)
+  if err != nil {
+    return nil, nil, err
+  }
+  certBlock, _ := pem.Decode(certPEM)
+  keyBlock, _ := pem.Decode(keyPEM)
+  if certBlock == nil || keyBlock == nil {
+    return nil, nil, fmt.Errorf("certs: %v or %v isn't PEM", certFile, keyFile)
+  }
+  cert, err := x509.ParseCertificate(certBlock.Bytes)
+  if err != nil {
+    return nil, nil, err
+  }
+  key, err := x509.ParseECPrivateKey(keyBlock.Bytes)
+  if err != nil {
+    return nil, nil, err
+  }
+  return cert, key, nil
+}
+
+func serial() (*big.Int, error) {
+  return rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+}
+
+func createCA(dir string) (*x509.Certificate, crypto.Signer, error) {
+  key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+  if err != nil {
+    return nil, nil, err
+  }
+  sn, err := serial()
+  if err != nil {
+    return nil, nil, err
+  }
+  host, _ := os.Hostname()
+  tmpl := &x509.Certificate{
+    SerialNumber: sn,
+    Subject: pkix.Name{
+      Organization: []string{"eg development CA"},
+      CommonName: "eg development CA (" + host + ")",
+    },
+    NotBefore: time.Now().Add(-time.Hour),
+    NotAfter: time.Now().AddDate(10, 0, 0),
+    KeyUsage: x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+    BasicConstraintsValid: true,
+    IsCA: true,
+    MaxPathLenZero: true,
+  }
+  der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+  if err != nil {
+    return nil, nil, err
+  }
+  if err := write(dir, CAFile, CAKeyFile, der, key); err != nil {
+    return nil, nil, err
+  }
+  cert, err := x509.ParseCertificate(der)
+  return cert, key, err
+}
+
+func createLeaf(dir string, ca *x509.Certificate, caKey crypto.Signer, hosts []string) error {
+  key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+  if err != nil {
+    return err
+  }
+  sn, err := serial()
+  if err != nil {
+    return err
+  }
+  tmpl := &x509.Certificate{
+    SerialNumber: sn,
+    Subject: pkix.Name{
+      Organization: []string{"eg development certificate"},
+    },
+    NotBefore: time.Now().Add(-time.Hour),
+    // Browsers reject leaf certificates that last much over a year.
+    NotAfter: time.Now().AddDate(1, 0, 0),
+    KeyUsage: x509.KeyUsageDigitalSignature,
+    ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+  }
+  for _, h := range hosts {
+    if ip := net.ParseIP(h); ip != nil {
+      tmpl.IPAddresses = append(tmpl.IPAddresses, ip)
+    } else {
+      tmpl.DNSNames = append(tmpl.DNSNames, h)
+    }
+  }
+  der, err := x509.CreateCertificate(rand.Reader, tmpl, ca, &key.PublicKey, caKey)
+  if err != nil {
+    return err
+  }
+  return write(dir, CertFile, KeyFile, der, key)
+}
+
+func write(dir string, certFile string, keyFile string, der []byte, key *ecdsa.PrivateKey) error {
+  keyDER, err := x509.MarshalECPrivateKey(key)
+  if err != nil {
+    return err
+  }
+  certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+  keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+  if err := ioutil.WriteFile(filepath.Join(dir, keyFile), keyPEM, 0600); err != nil {
+    return err
+  }
+  return ioutil.WriteFile(filepath.Join(dir, certFile), certPEM, 0644)
+}
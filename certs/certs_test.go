@@ -0,0 +1,69 @@
+package certs
+
+import (
+  "bytes"
+  "crypto/x509"
+  "io/ioutil"
+  "path/filepath"
+  "testing"
+)
+
+func TestLoad(t *testing.T) {
+  dir := t.TempDir()
+  cert, created, err := Load(dir, []string{"demo.test"})
+  if err != nil {
+    t.Fatal(err)
+  }
+  if !created {
+    t.Errorf("the first Load didn't report a new CA")
+  }
+  caPEM, _ := ioutil.ReadFile(filepath.Join(dir, CAFile))
+  roots := x509.NewCertPool()
+  roots.AppendCertsFromPEM(caPEM)
+  leaf, err := x509.ParseCertificate(cert.Certificate[0])
+  if err != nil {
+    t.Fatal(err)
+  }
+  for _, host := range []string{"localhost", "127.0.0.1", "demo.test"} {
+    if _, err := leaf.Verify(x509.VerifyOptions{DNSName: host, Roots: roots}); err != nil {
+      t.Errorf("the certificate isn't valid for %v: %v", host, err)
+    }
+  }
+
+  leafPEM, _ := ioutil.ReadFile(filepath.Join(dir, CertFile))
+  if _, created, err = Load(dir, []string{"demo.test"}); err != nil || created {
+    t.Fatalf("Load again = %v, %v; want the existing CA", created, err)
+  }
+  again, _ := ioutil.ReadFile(filepath.Join(dir, CertFile))
+  if !bytes.Equal(leafPEM, again) {
+    t.Errorf("the certificate was remade for the same hosts")
+  }
+
+  cert, _, err = Load(dir, []string{"other.test"})
+  if err != nil {
+    t.Fatal(err)
+  }
+  leaf, _ = x509.ParseCertificate(cert.Certificate[0])
+  if _, err := leaf.Verify(x509.VerifyOptions{DNSName: "other.test", Roots: roots}); err != nil {
+    t.Errorf("the certificate wasn't remade for a new host: %v", err)
+  }
+}
+
+func TestPool(t *testing.T) {
+  dir := t.TempDir()
+  if _, err := Pool(dir); err == nil {
+    t.Errorf("Pool without a CA = nil error, want one")
+  }
+  cert, _, err := Load(dir, nil)
+  if err != nil {
+    t.Fatal(err)
+  }
+  pool, err := Pool(dir)
+  if err != nil {
+    t.Fatal(err)
+  }
+  leaf, _ := x509.ParseCertificate(cert.Certificate[0])
+  if _, err := leaf.Verify(x509.VerifyOptions{DNSName: "localhost", Roots: pool}); err != nil {
+    t.Errorf("the pool doesn't trust the certificate: %v", err)
+  }
+}
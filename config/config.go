@@ -0,0 +1,437 @@
+// Package config provides the typed settings of an ego app. Settings are read
+// from conf/app.json (or eg.toml), merged with the section for the current
+// environment and then with EG_* environment variables.
+package config
+
+import (
+  "bytes"
+  "encoding/json"
+  "fmt"
+  "io/ioutil"
+  "os"
+  "reflect"
+  "sort"
+  "strconv"
+  "strings"
+  "github.com/BurntSushi/toml"
+)
+
+const (
+  JSONFile = "conf/app.json"
+  TOMLFile = "eg.toml"
+)
+
+// Environments are the sections of the config file that are merged over the
+// top-level settings when eg runs in that environment.
+var Environments = []string{"development", "test", "production"}
+
+type Config struct {
+  Env string `json:"-"`
+  Name string `json:"name"`
+  Port int `json:"port"`
+  ProxyPort int `json:"proxy_port"`
+  HTTPSPort int `json:"https_port"`
+  Hosts []string `json:"hosts"`
+  Watch []string `json:"watch"`
+  Assets []string `json:"assets"`
+  EnvFiles []string `json:"env_files"`
+  VolatileHeaders []string `json:"volatile_headers"`
+  // ErrorDetails adds the request and stack trace to the error pages of an app
+  // run by eg. Apps built for production only serve the static pages.
+  ErrorDetails bool `json:"error_details"`
+  // Database is the app's primary database, where `eg db` connects to. Its
+  // test section can point at a SQLite file so tests run offline.
+  Database Database `json:"database"`
+  // Databases are the app's other databases by name, such as an analytics
+  // one, which `eg db --db <name>` connects to.
+  Databases map[string]Database `json:"databases"`
+}
+
+// Primary is the name of the database in the database section.
+const Primary = "primary"
+
+type Database struct {
+  Driver string `json:"driver"`
+  DSN string `json:"dsn"`
+  // MaxOpenConns and MaxIdleConns size the connection pool, and connections
+  // are closed after ConnMaxLifetime, such as "30m". Zero values keep
+  // database/sql's defaults.
+  MaxOpenConns int `json:"max_open_conns,omitempty"`
+  MaxIdleConns int `json:"max_idle_conns,omitempty"`
+  ConnMaxLifetime string `json:"conn_max_lifetime,omitempty"`
+  // Replicas are the DSNs of read replicas, using the same driver.
+  Replicas []string `json:"replicas,omitempty"`
+}
+
+// DB returns the database named name, where "" is the primary one.
+func (c *Config) DB(name string) (Database, error) {
+  if name == "" || name == Primary {
+    return c.Database, nil
+  }
+  if db, ok := c.Databases[name]; ok {
+    return db, nil
+  }
+  names := []string{Primary}
+  for n := range c.Databases {
+    names = append(names, n)
+  }
+  sort.Strings(names[1:])
+  return Database{}, fmt.Errorf("config: no database named %q, use one of %v", name, strings.Join(names, ", "))
+}
+
+// AllDBs returns the configured databases by name, with the primary one
+// under Primary.
+func (c *Config) AllDBs() map[string]Database {
+  dbs := make(map[string]Database)
+  if c.Database.Driver != "" {
+    dbs[Primary] = c.Database
+  }
+  for name, db := range c.Databases {
+    dbs[name] = db
+  }
+  return dbs
+}
+
+// Drivers returns the database drivers of every environment's databases,
+// sorted, for a binary that may run in any of them.
+func Drivers() ([]string, error) {
+  f, err := Open()
+  if err != nil {
+    return nil, err
+  }
+  seen := make(map[string]bool)
+  for _, e := range append(append([]string{}, Environments...), Env()) {
+    c := Defaults()
+    data, err := json.Marshal(f.Merged(e))
+    if err != nil {
+      return nil, err
+    }
+    if err := json.Unmarshal(data, c); err != nil {
+      return nil, fmt.Errorf("config: %v: %v", f.Path, err)
+    }
+    for _, db := range c.AllDBs() {
+      if db.Driver != "" {
+        seen[db.Driver] = true
+      }
+    }
+  }
+  drivers := make([]string, 0, len(seen))
+  for d := range seen {
+    drivers = append(drivers, d)
+  }
+  sort.Strings(drivers)
+  return drivers, nil
+}
+
+// Defaults returns the settings used when the config file doesn't override
+// them.
+func Defaults() *Config {
+  return &Config{
+    Env: "development",
+    Port: 5000,
+    ProxyPort: 5050,
+    HTTPSPort: 5443,
+    ErrorDetails: true,
+    Watch: []string{
+      "app/controllers",
+      "app/helpers",
+      "app/views",
+      "conf",
+    },
+    Assets: []string{
+      "app/assets/javascripts",
+      "app/assets/stylesheets",
+      "app/assets/images",
+    },
+    VolatileHeaders: []string{
+      "Date",
+      "Content-Length",
+      "Etag",
+      "Last-Modified",
+      "Expires",
+      "Set-Cookie",
+      "X-Request-Id",
+    },
+  }
+}
+
+var env = ""
+var current *Config
+
+// Env returns the environment eg runs in: the one given to SetEnv, else
+// $EG_ENV, else development.
+func Env() string {
+  if env != "" {
+    return env
+  }
+  if e := os.Getenv("EG_ENV"); e != "" {
+    return e
+  }
+  return "development"
+}
+
+// SetEnv selects the environment and drops any config loaded for another one.
+func SetEnv(e string) {
+  env = e
+  current = nil
+}
+
+// Current returns the config for the current environment, loading it on first
+// use. If the config file can't be read the defaults are returned.
+func Current() *Config {
+  if current == nil {
+    c, err := Load()
+    if err != nil {
+      c = Defaults()
+      c.Env = Env()
+    }
+    current = c
+  }
+  return current
+}
+
+// Load reads the config file and returns the settings for the current
+// environment.
+func Load() (*Config, error) {
+  f, err := Open()
+  if err != nil {
+    return nil, err
+  }
+  c := Defaults()
+  c.Env = Env()
+  data, err := json.Marshal(f.Merged(c.Env))
+  if err != nil {
+    return nil, err
+  }
+  if err := json.Unmarshal(data, c); err != nil {
+    return nil, fmt.Errorf("config: %v: %v", f.Path, err)
+  }
+  if err := applyEnv(c); err != nil {
+    return nil, err
+  }
+  current = c
+  return c, nil
+}
+
+// EnvVar returns the environment variable that overrides a config key, e.g.
+// EG_PROXY_PORT for proxy_port.
+func EnvVar(key string) string {
+  return "EG_" + strings.ToUpper(strings.Replace(key, ".", "_", -1))
+}
+
+// applyEnv overrides fields of c with the EG_* environment variables that are
+// set. Slices are comma separated.
+func applyEnv(c *Config) error {
+  v := reflect.ValueOf(c).Elem()
+  t := v.Type()
+  for i := 0; i < t.NumField(); i++ {
+    key := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+    if key == "" || key == "-" {
+      continue
+    }
+    val, ok := os.LookupEnv(EnvVar(key))
+    if !ok {
+      continue
+    }
+    field := v.Field(i)
+    switch field.Kind() {
+    case reflect.String:
+      field.SetString(val)
+    case reflect.Int:
+      n, err := strconv.Atoi(val)
+      if err != nil {
+        return fmt.Errorf("config: %v: %v", EnvVar(key), err)
+      }
+      field.SetInt(int64(n))
+    case reflect.Bool:
+      b, err := strconv.ParseBool(val)
+      if err != nil {
+        return fmt.Errorf("config: %v: %v", EnvVar(key), err)
+      }
+      field.SetBool(b)
+    case reflect.Slice:
+      field.Set(reflect.ValueOf(strings.Split(val, ",")))
+    }
+  }
+  return nil
+}
+
+// File is the raw contents of a config file, as edited by `eg config`.
+type File struct {
+  Path string
+  Data map[string]interface{}
+}
+
+// Open reads conf/app.json, or eg.toml if there is no conf/app.json. When
+// neither exists it returns an empty file that saves to conf/app.json.
+func Open() (*File, error) {
+  f := &File{
+    Path: JSONFile,
+    Data: make(map[string]interface{}),
+  }
+  if _, err := os.Stat(JSONFile); err != nil {
+    if _, err := os.Stat(TOMLFile); err == nil {
+      f.Path = TOMLFile
+    }
+  }
+  txt, err := ioutil.ReadFile(f.Path)
+  if os.IsNotExist(err) {
+    return f, nil
+  }
+  if err != nil {
+    return nil, err
+  }
+  if f.Path == TOMLFile {
+    err = toml.Unmarshal(txt, &f.Data)
+  } else if len(bytes.TrimSpace(txt)) > 0 {
+    err = json.Unmarshal(txt, &f.Data)
+  }
+  if err != nil {
+    return nil, fmt.Errorf("config: %v: %v", f.Path, err)
+  }
+  return f, nil
+}
+
+func isEnvironment(key string) bool {
+  for _, e := range Environments {
+    if e == key {
+      return true
+    }
+  }
+  return false
+}
+
+// Merged returns the top-level settings with the section for env merged over
+// them. The environment sections themselves are left out.
+func (f *File) Merged(env string) map[string]interface{} {
+  merged := make(map[string]interface{})
+  for k, v := range f.Data {
+    if !isEnvironment(k) {
+      merged[k] = v
+    }
+  }
+  if section, ok := f.Data[env].(map[string]interface{}); ok {
+    for k, v := range section {
+      merged[k] = v
+    }
+  }
+  return merged
+}
+
+// Get returns the value of a dotted key such as "production.port".
+func (f *File) Get(key string) (interface{}, bool) {
+  var v interface{} = f.Data
+  for _, piece := range strings.Split(key, ".") {
+    m, ok := v.(map[string]interface{})
+    if !ok {
+      return nil, false
+    }
+    if v, ok = m[piece]; !ok {
+      return nil, false
+    }
+  }
+  return v, true
+}
+
+// Set sets a dotted key, creating sections as needed. The value is parsed as
+// JSON when possible (so 5000 is a number and [] a list), else kept as a
+// string.
+func (f *File) Set(key string, value string) {
+  var v interface{}
+  dec := json.NewDecoder(strings.NewReader(value))
+  dec.UseNumber()
+  if err := dec.Decode(&v); err != nil || dec.More() {
+    v = value
+  } else if n, ok := v.(json.Number); ok {
+    // Keep whole numbers integers, so they stay that way in eg.toml.
+    if i, err := n.Int64(); err == nil {
+      v = i
+    } else {
+      v, _ = n.Float64()
+    }
+  }
+  pieces := strings.Split(key, ".")
+  m := f.Data
+  for _, piece := range pieces[0:len(pieces) - 1] {
+    next, ok := m[piece].(map[string]interface{})
+    if !ok {
+      next = make(map[string]interface{})
+      m[piece] = next
+    }
+    m = next
+  }
+  m[pieces[len(pieces) - 1]] = v
+}
+
+// Flatten returns every leaf of data keyed by its dotted path.
+func Flatten(data map[string]interface{}) map[string]interface{} {
+  flat := make(map[string]interface{})
+  var walk func(prefix string, m map[string]interface{})
+  walk = func(prefix string, m map[string]interface{}) {
+    for k, v := range m {
+      if sub, ok := v.(map[string]interface{}); ok {
+        walk(prefix + k + ".", sub)
+      } else {
+        flat[prefix + k] = v
+      }
+    }
+  }
+  walk("", data)
+  return flat
+}
+
+// Keys returns the keys of a flattened map, sorted.
+func Keys(flat map[string]interface{}) []string {
+  keys := make([]string, 0, len(flat))
+  for k := range flat {
+    keys = append(keys, k)
+  }
+  sort.Strings(keys)
+  return keys
+}
+
+// Save writes the file back in the format it was read in.
+func (f *File) Save() error {
+  var buf bytes.Buffer
+  if f.Path == TOMLFile {
+    if err := toml.NewEncoder(&buf).Encode(f.Data); err != nil {
+      return err
+    }
+  } else {
+    data, err := json.MarshalIndent(f.Data, "", "\t")
+    if err != nil {
+      return err
+    }
+    buf.Write(data)
+    buf.WriteString("\n")
+  }
+  return ioutil.WriteFile(f.Path, buf.Bytes(), 0666)
+}
+
+// Effective returns the flattened settings eg uses in the current
+// environment, including defaults and environment variable overrides.
+func Effective() (map[string]interface{}, error) {
+  c, err := Load()
+  if err != nil {
+    return nil, err
+  }
+  data, err := json.Marshal(c)
+  if err != nil {
+    return nil, err
+  }
+  m := make(map[string]interface{})
+  if err := json.Unmarshal(data, &m); err != nil {
+    return nil, err
+  }
+  f, err := Open()
+  if err != nil {
+    return nil, err
+  }
+  // Keep app-specific keys that Config doesn't know about.
+  for k, v := range f.Merged(c.Env) {
+    if _, ok := m[k]; !ok {
+      m[k] = v
+    }
+  }
+  return Flatten(m), nil
+}
@@ -0,0 +1,149 @@
+package config
+
+import (
+  "io/ioutil"
+  "os"
+  "path/filepath"
+  "reflect"
+  "strings"
+  "testing"
+)
+
+// inApp changes into a temporary app with a config file named name until the
+// end of the test.
+func inApp(t *testing.T, name string, content string) {
+  dir := t.TempDir()
+  os.MkdirAll(filepath.Join(dir, "conf"), 0777)
+  if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0666); err != nil {
+    t.Fatal(err)
+  }
+  wd, _ := os.Getwd()
+  if err := os.Chdir(dir); err != nil {
+    t.Fatal(err)
+  }
+  t.Cleanup(func() {
+    os.Chdir(wd)
+    SetEnv("")
+  })
+}
+
+func TestPrecedence(t *testing.T) {
+  tests := []struct {
+    name string
+    file string
+    content string
+    env string
+    vars map[string]string
+    want int
+  }{
+    {"defaults", JSONFile, `{}`, "", nil, 5000},
+    {"file", JSONFile, `{"port": 6000}`, "", nil, 6000},
+    {"toml file", TOMLFile, "port = 6000\n", "", nil, 6000},
+    {"env section", JSONFile, `{"port": 6000, "development": {"port": 7000}}`, "", nil, 7000},
+    {"other env section", JSONFile, `{"port": 6000, "development": {"port": 7000}}`, "production", nil, 6000},
+    {"EG_ENV", JSONFile, `{"port": 6000, "production": {"port": 7000}}`, "", map[string]string{"EG_ENV": "production"}, 7000},
+    {"EG_PORT", JSONFile, `{"port": 6000, "development": {"port": 7000}}`, "", map[string]string{"EG_PORT": "8000"}, 8000},
+    {"EG_PORT in toml", TOMLFile, "port = 6000\n[development]\nport = 7000\n", "", map[string]string{"EG_PORT": "8000"}, 8000},
+  }
+  for _, test := range tests {
+    t.Run(test.name, func(t *testing.T) {
+      inApp(t, test.file, test.content)
+      for k, v := range test.vars {
+        t.Setenv(k, v)
+      }
+      SetEnv(test.env)
+      c, err := Load()
+      if err != nil {
+        t.Fatal(err)
+      }
+      if c.Port != test.want {
+        t.Errorf("port = %v, want %v", c.Port, test.want)
+      }
+    })
+  }
+}
+
+func TestBadConfig(t *testing.T) {
+  tests := []struct {
+    name string
+    file string
+    content string
+    vars map[string]string
+    err string
+  }{
+    {"bad toml", TOMLFile, "port = \n", nil, "config: eg.toml:"},
+    {"bad json", JSONFile, `{"port": }`, nil, "config: conf/app.json:"},
+    {"wrong type", JSONFile, `{"port": "high"}`, nil, "config: conf/app.json:"},
+    {"bad EG_PORT", JSONFile, `{}`, map[string]string{"EG_PORT": "high"}, "config: EG_PORT:"},
+    {"bad EG_ERROR_DETAILS", JSONFile, `{}`, map[string]string{"EG_ERROR_DETAILS": "maybe"}, "config: EG_ERROR_DETAILS:"},
+  }
+  for _, test := range tests {
+    t.Run(test.name, func(t *testing.T) {
+      inApp(t, test.file, test.content)
+      for k, v := range test.vars {
+        t.Setenv(k, v)
+      }
+      if _, err := Load(); err == nil || !strings.HasPrefix(err.Error(), test.err) {
+        t.Errorf("Load = %v, want an error starting with %q", err, test.err)
+      }
+    })
+  }
+}
+
+func TestSet(t *testing.T) {
+  tests := []struct {
+    value string
+    want interface{}
+  }{
+    {"6000", int64(6000)},
+    {"1.5", 1.5},
+    {"true", true},
+    {`["a.test", "b.test"]`, []interface{}{"a.test", "b.test"}},
+    {"localhost", "localhost"},
+    {"6000 7000", "6000 7000"},
+    {"", ""},
+  }
+  for _, test := range tests {
+    f := &File{Path: JSONFile, Data: map[string]interface{}{}}
+    f.Set("production.key", test.value)
+    if got, ok := f.Get("production.key"); !ok || !reflect.DeepEqual(got, test.want) {
+      t.Errorf("Set(%q) = %#v, want %#v", test.value, got, test.want)
+    }
+  }
+}
+
+// TestSetTypedKeys saves values set as strings and loads them into the typed
+// fields of Config, in both file formats.
+func TestSetTypedKeys(t *testing.T) {
+  for _, name := range []string{JSONFile, TOMLFile} {
+    t.Run(name, func(t *testing.T) {
+      inApp(t, name, "")
+      f, err := Open()
+      if err != nil {
+        t.Fatal(err)
+      }
+      f.Set("development.port", "6000")
+      f.Set("error_details", "false")
+      f.Set("hosts", `["a.test"]`)
+      f.Set("database.driver", "sqlite")
+      if err := f.Save(); err != nil {
+        t.Fatal(err)
+      }
+      c, err := Load()
+      if err != nil {
+        t.Fatal(err)
+      }
+      if c.Port != 6000 || c.ErrorDetails || !reflect.DeepEqual(c.Hosts, []string{"a.test"}) || c.Database.Driver != "sqlite" {
+        t.Errorf("loaded port %v, error_details %v, hosts %v, driver %q", c.Port, c.ErrorDetails, c.Hosts, c.Database.Driver)
+      }
+    })
+  }
+}
+
+func TestDrivers(t *testing.T) {
+  inApp(t, JSONFile, `{"database": {"driver": "postgres"}, "databases": {"stats": {"driver": "postgres"}}, "test": {"database": {"driver": "sqlite"}}}`)
+  drivers, err := Drivers()
+  if err != nil || strings.Join(drivers, ",") != "postgres,sqlite" {
+    t.Errorf("Drivers = %v, %v, want postgres and sqlite", drivers, err)
+  }
+}
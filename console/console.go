@@ -0,0 +1,104 @@
+// Package console provides `eg console`: a helper binary that links the app's
+// conf and app/models packages and evaluates Go typed at a prompt against
+// them, with an interpreter embedded in the binary.
+package console
+
+import (
+  "io/ioutil"
+  "os"
+  "path/filepath"
+  "sort"
+  "github.com/hoisie/mustache"
+  "github.com/murz/eg/inspector"
+  "github.com/murz/eg/logger"
+  "github.com/murz/eg/proxy"
+  "github.com/murz/eg/templates"
+)
+
+const (
+  // Dir is the generated package of the console, next to the server's.
+  Dir = ".ego-genfiles/console"
+  Bin = ".ego-genfiles/ego-console"
+  HistoryFile = ".ego-genfiles/console_history"
+)
+
+// Deps are the modules the console is built with, which proxy.Require adds to
+// the app's go.mod.
+var Deps = []string{
+  "github.com/traefik/yaegi@v0.16.1",
+  "github.com/peterh/liner@v1.2.2",
+}
+
+var buildLog = logger.New("build")
+
+// Data is what the console's main.go is rendered from, after the app has
+// been inspected.
+func Data() map[string]interface{} {
+  wd, _ := os.Getwd()
+  words := make([]string, 0)
+
+  models := make([]map[string]interface{}, 0)
+  for _, m := range inspector.GetModels() {
+    models = append(models, map[string]interface{}{
+      "Name": m.Name,
+      "Type": m.Kind == "type",
+      "Var": m.Kind == "var",
+      "Value": m.Kind == "func" || m.Kind == "const",
+    })
+    words = append(words, "models." + m.Name)
+  }
+
+  controllers := make([]map[string]interface{}, 0)
+  seen := make(map[string]bool)
+  for _, a := range inspector.GetActions() {
+    if !seen[a.Controller] {
+      seen[a.Controller] = true
+      controllers = append(controllers, map[string]interface{}{"Name": a.Controller})
+      words = append(words, "controllers." + a.Controller + "{}")
+    }
+    words = append(words, "controllers." + a.Controller + "{}." + a.Name + "(")
+  }
+
+  sort.Strings(words)
+  wordData := make([]map[string]string, len(words))
+  for i, w := range words {
+    wordData[i] = map[string]string{"Word": w}
+  }
+  return map[string]interface{}{
+    "Name": filepath.Base(wd),
+    "Module": inspector.GetModule(),
+    "HasModels": len(models) > 0,
+    "Models": models,
+    "HasActions": len(controllers) > 0,
+    "Controllers": controllers,
+    "Words": wordData,
+    "HistoryFile": HistoryFile,
+  }
+}
+
+// Generate inspects the app in the working directory and writes the
+// console's main.go.
+func Generate() error {
+  inspector.InitActions()
+  inspector.Inspect()
+  if err := os.MkdirAll(Dir, 0777); err != nil {
+    return err
+  }
+  code := mustache.Render(string(templates.Get("console.go.mustache")), Data())
+  return ioutil.WriteFile(filepath.Join(Dir, "main.go"), []byte(code), 0666)
+}
+
+// Build generates the console and compiles it with builder, returning the
+// path of the binary.
+func Build(builder proxy.Builder) (string, error) {
+  root, _ := os.Getwd()
+  if err := Generate(); err != nil {
+    return "", err
+  }
+  if err := proxy.Require(root, Deps...); err != nil {
+    return "", err
+  }
+  bin := filepath.Join(root, Bin)
+  buildLog.Infof("compiling the console")
+  return bin, builder.Build(root, filepath.Join(root, Dir), bin)
+}
@@ -0,0 +1,72 @@
+package console
+
+import (
+  "io/ioutil"
+  "os"
+  "path/filepath"
+  "strings"
+  "testing"
+)
+
+func setupApp(t *testing.T, files map[string]string) {
+  dir := t.TempDir()
+  for name, content := range files {
+    filename := filepath.Join(dir, name)
+    os.MkdirAll(filepath.Dir(filename), 0777)
+    if err := ioutil.WriteFile(filename, []byte(content), 0666); err != nil {
+      t.Fatal(err)
+    }
+  }
+  wd, _ := os.Getwd()
+  if err := os.Chdir(dir); err != nil {
+    t.Fatal(err)
+  }
+  t.Cleanup(func() {
+    os.Chdir(wd)
+  })
+}
+
+func TestGenerate(t *testing.T) {
+  setupApp(t, map[string]string{
+    "go.mod": "module example.com/demo\n",
+    "app/controllers/posts_controller.go": "package controllers\n\ntype PostsController struct{}\n\nfunc (c PostsController) Index() {}\n",
+    "app/models/post.go": "package models\n\nconst Max = 3\n\nvar Count int\n\ntype Post struct{}\n\nfunc FindPost(id int) *Post { return nil }\n",
+  })
+  if err := Generate(); err != nil {
+    t.Fatal(err)
+  }
+  code, err := ioutil.ReadFile(filepath.Join(Dir, "main.go"))
+  if err != nil {
+    t.Fatal(err)
+  }
+  for _, want := range []string{
+    `"example.com/demo/app/models"`,
+    `"example.com/demo/app/models/models": {`,
+    `"Post": reflect.ValueOf((*models.Post)(nil)),`,
+    `"Count": reflect.ValueOf(&models.Count).Elem(),`,
+    `"Max": reflect.ValueOf(models.Max),`,
+    `"FindPost": reflect.ValueOf(models.FindPost),`,
+    `"PostsController": reflect.ValueOf((*controllers.PostsController)(nil)),`,
+    `"controllers.PostsController{}.Index(",`,
+    `"models.FindPost",`,
+    `const historyFile = ".ego-genfiles/console_history"`,
+  } {
+    if !strings.Contains(string(code), want) {
+      t.Errorf("main.go doesn't contain %v:\n%s", want, code)
+    }
+  }
+}
+
+func TestGenerateWithoutModels(t *testing.T) {
+  setupApp(t, map[string]string{
+    "go.mod": "module example.com/demo\n",
+    "app/controllers/doc.go": "package controllers\n",
+  })
+  if err := Generate(); err != nil {
+    t.Fatal(err)
+  }
+  code, _ := ioutil.ReadFile(filepath.Join(Dir, "main.go"))
+  if strings.Contains(string(code), "app/models") || strings.Contains(string(code), "app/controllers") {
+    t.Errorf("main.go imports packages the app doesn't have:\n%s", code)
+  }
+}
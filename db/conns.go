@@ -0,0 +1,231 @@
+package db
+
+import (
+  "database/sql"
+  "encoding/json"
+  "fmt"
+  "os"
+  "sort"
+  "strings"
+  "sync"
+  "sync/atomic"
+  "time"
+)
+
+// EnvVar holds the app's databases as JSON, by name, which eg sets when it
+// runs the app from the database sections of the config.
+const EnvVar = "EGO_DATABASES"
+
+// Primary is the name of the app's main database.
+const Primary = "primary"
+
+// Path returns where a file of the primary database, such as SchemaFile, is
+// kept for the database named name: db/schema.sql is db/analytics/schema.sql.
+func Path(name string, file string) string {
+  if name == "" || name == Primary {
+    return file
+  }
+  return "db/" + name + "/" + strings.TrimPrefix(file, "db/")
+}
+
+// Config is how to connect to one of the app's databases.
+type Config struct {
+  Driver string `json:"driver"`
+  DSN string `json:"dsn"`
+  MaxOpenConns int `json:"max_open_conns,omitempty"`
+  MaxIdleConns int `json:"max_idle_conns,omitempty"`
+  ConnMaxLifetime string `json:"conn_max_lifetime,omitempty"`
+  Replicas []string `json:"replicas,omitempty"`
+}
+
+// Conn is a named database of the app. Queries on it go to the primary,
+// while Reader spreads reads over the replicas.
+type Conn struct {
+  *sql.DB
+  Name string
+  replicas []*sql.DB
+  next uint32
+}
+
+// Reader returns the next replica in turn, or the primary if there are none.
+func (c *Conn) Reader() *sql.DB {
+  if len(c.replicas) == 0 {
+    return c.DB
+  }
+  n := atomic.AddUint32(&c.next, 1)
+  return c.replicas[int(n - 1) % len(c.replicas)]
+}
+
+// Ping checks that the primary and the replicas are reachable.
+func (c *Conn) Ping() error {
+  if err := c.DB.Ping(); err != nil {
+    return err
+  }
+  for _, r := range c.replicas {
+    if err := r.Ping(); err != nil {
+      return err
+    }
+  }
+  return nil
+}
+
+// Close closes the primary and the replicas.
+func (c *Conn) Close() error {
+  err := c.DB.Close()
+  for _, r := range c.replicas {
+    r.Close()
+  }
+  return err
+}
+
+// Connect opens a database with its pool settings and replicas. Like
+// sql.Open, it doesn't connect until the database is used.
+func Connect(name string, cfg Config) (*Conn, error) {
+  d, err := Lookup(cfg.Driver)
+  if err != nil {
+    return nil, fmt.Errorf("db: %v: %v", name, err)
+  }
+  var lifetime time.Duration
+  if cfg.ConnMaxLifetime != "" {
+    if lifetime, err = time.ParseDuration(cfg.ConnMaxLifetime); err != nil {
+      return nil, fmt.Errorf("db: %v: conn_max_lifetime: %v", name, err)
+    }
+  }
+  open := func(dsn string) (*sql.DB, error) {
+    conn, err := sql.Open(d.Name, dsn)
+    if err != nil {
+      return nil, fmt.Errorf("db: %v: %v", name, err)
+    }
+    if cfg.MaxOpenConns > 0 {
+      conn.SetMaxOpenConns(cfg.MaxOpenConns)
+    }
+    if cfg.MaxIdleConns > 0 {
+      conn.SetMaxIdleConns(cfg.MaxIdleConns)
+    }
+    if lifetime > 0 {
+      conn.SetConnMaxLifetime(lifetime)
+    }
+    return conn, nil
+  }
+  c := &Conn{Name: name}
+  if c.DB, err = open(cfg.DSN); err != nil {
+    return nil, err
+  }
+  for _, dsn := range cfg.Replicas {
+    r, err := open(dsn)
+    if err != nil {
+      c.Close()
+      return nil, err
+    }
+    c.replicas = append(c.replicas, r)
+  }
+  return c, nil
+}
+
+var (
+  connsMu sync.Mutex
+  conns = make(map[string]*Conn)
+)
+
+// Configs returns the app's databases from EGO_DATABASES, or the primary one
+// from EGO_DB_DRIVER and EGO_DB_DSN.
+func Configs() (map[string]Config, error) {
+  configs := make(map[string]Config)
+  if data := os.Getenv(EnvVar); data != "" {
+    if err := json.Unmarshal([]byte(data), &configs); err != nil {
+      return nil, fmt.Errorf("db: %v: %v", EnvVar, err)
+    }
+  }
+  if _, ok := configs[Primary]; !ok && os.Getenv("EGO_DB_DRIVER") != "" {
+    configs[Primary] = Config{Driver: os.Getenv("EGO_DB_DRIVER"), DSN: os.Getenv("EGO_DB_DSN")}
+  }
+  return configs, nil
+}
+
+// Use returns the app's database named name, connecting to it the first time.
+// It panics if there's no such database, as a model using one that isn't
+// configured can't work.
+func Use(name string) *Conn {
+  c, err := use(name)
+  if err != nil {
+    panic(err)
+  }
+  return c
+}
+
+func use(name string) (*Conn, error) {
+  connsMu.Lock()
+  defer connsMu.Unlock()
+  if c, ok := conns[name]; ok {
+    return c, nil
+  }
+  configs, err := Configs()
+  if err != nil {
+    return nil, err
+  }
+  cfg, ok := configs[name]
+  if !ok {
+    names := make([]string, 0)
+    for n := range configs {
+      names = append(names, n)
+    }
+    sort.Strings(names)
+    return nil, fmt.Errorf("db: no database named %q is configured, the app has %v", name, strings.Join(names, ", "))
+  }
+  c, err := Connect(name, cfg)
+  if err != nil {
+    return nil, err
+  }
+  conns[name] = c
+  return c, nil
+}
+
+// Database is implemented by models stored in a database other than the
+// primary one:
+//
+//   func (Event) Database() string { return "analytics" }
+type Database interface {
+  Database() string
+}
+
+// For returns the database a model is stored in.
+func For(model interface{}) *Conn {
+  if m, ok := model.(Database); ok {
+    return Use(m.Database())
+  }
+  return Use(Primary)
+}
+
+// ConnectAll connects to each of the app's databases and checks that it's
+// reachable, returning the first error.
+func ConnectAll() error {
+  configs, err := Configs()
+  if err != nil {
+    return err
+  }
+  names := make([]string, 0, len(configs))
+  for n := range configs {
+    names = append(names, n)
+  }
+  sort.Strings(names)
+  for _, n := range names {
+    c, err := use(n)
+    if err != nil {
+      return err
+    }
+    if err := c.Ping(); err != nil {
+      return fmt.Errorf("db: %v: %v", n, err)
+    }
+  }
+  return nil
+}
+
+// CloseAll closes the databases the app has connected to.
+func CloseAll() {
+  connsMu.Lock()
+  defer connsMu.Unlock()
+  for name, c := range conns {
+    c.Close()
+    delete(conns, name)
+  }
+}
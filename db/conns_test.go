@@ -0,0 +1,75 @@
+package db
+
+import (
+  "path/filepath"
+  "strings"
+  "testing"
+)
+
+type event struct{}
+
+func (event) Database() string {
+  return "analytics"
+}
+
+func TestPath(t *testing.T) {
+  for _, c := range []struct{ name, file, want string }{
+    {"", SchemaFile, "db/schema.sql"},
+    {Primary, SeedsDir, "db/seeds"},
+    {"analytics", SchemaFile, "db/analytics/schema.sql"},
+    {"analytics", MigrationsDir, "db/analytics/migrations"},
+  } {
+    if got := Path(c.name, c.file); got != c.want {
+      t.Errorf("Path(%q, %q) = %v, want %v", c.name, c.file, got, c.want)
+    }
+  }
+}
+
+func TestUse(t *testing.T) {
+  dir := t.TempDir()
+  t.Setenv("EGO_DB_DRIVER", "sqlite")
+  t.Setenv("EGO_DB_DSN", filepath.Join(dir, "main.db"))
+  t.Setenv(EnvVar, `{
+    "analytics": {
+      "driver": "sqlite3",
+      "dsn": "` + filepath.Join(dir, "analytics.db") + `",
+      "max_open_conns": 2,
+      "conn_max_lifetime": "1m",
+      "replicas": ["` + filepath.Join(dir, "r1.db") + `", "` + filepath.Join(dir, "r2.db") + `"]
+    }
+  }`)
+  t.Cleanup(CloseAll)
+
+  if err := ConnectAll(); err != nil {
+    t.Fatal(err)
+  }
+  analytics := Use("analytics")
+  if For(event{}) != analytics || For(struct{}{}) != Use(Primary) || Use(Primary) == analytics {
+    t.Errorf("For didn't return the database the model is stored in")
+  }
+  if n := analytics.Stats().MaxOpenConnections; n != 2 {
+    t.Errorf("max open conns = %v, want 2", n)
+  }
+  if a, b := analytics.Reader(), analytics.Reader(); a == b || a == analytics.DB || analytics.Reader() != a {
+    t.Errorf("Reader didn't take turns between the replicas")
+  }
+  if r := Use(Primary).Reader(); r != Use(Primary).DB {
+    t.Errorf("Reader of a database without replicas isn't the primary")
+  }
+
+  defer func() {
+    if r := recover(); r == nil || !strings.Contains(r.(error).Error(), `no database named "missing"`) {
+      t.Errorf("Use of a missing database panicked with %v", r)
+    }
+  }()
+  Use("missing")
+}
+
+func TestConnectAllReportsBadConfigs(t *testing.T) {
+  t.Setenv("EGO_DB_DRIVER", "")
+  t.Setenv(EnvVar, `{"old": {"driver": "mysql", "dsn": "x"}}`)
+  t.Cleanup(CloseAll)
+  if err := ConnectAll(); err == nil || !strings.Contains(err.Error(), "db: old: db: unknown driver") {
+    t.Errorf("ConnectAll = %v, want the unknown driver", err)
+  }
+}
@@ -0,0 +1,152 @@
+// Package db provides eg's database tasks: connecting to the app's database
+// with the driver named in its config, loading YAML seeds and fixtures into it
+// in dependency order, and dumping and diffing its schema. Apps use it for
+// their named databases too, with db.Use and db.For. SQLite is supported with
+// a pure Go driver, so none of it needs cgo or a database server.
+//
+// The package doesn't import the drivers, so apps only link the ones they
+// use: the server eg generates imports those of the configured databases.
+package db
+
+import (
+  "database/sql"
+  "fmt"
+  "sort"
+  "strings"
+)
+
+// Driver is a database driver eg can connect with, along with what the app's
+// generated helpers need to import to use it.
+type Driver struct {
+  Name string
+  Import string
+  Module string
+  Dialect Dialect
+}
+
+var Drivers = map[string]*Driver{
+  "sqlite": &Driver{
+    Name: "sqlite",
+    Import: "modernc.org/sqlite",
+    Module: "modernc.org/sqlite@v1.34.4",
+    Dialect: SQLite,
+  },
+  "postgres": &Driver{
+    Name: "postgres",
+    Import: "github.com/lib/pq",
+    Module: "github.com/lib/pq@v1.10.9",
+    Dialect: Postgres,
+  },
+}
+
+var aliases = map[string]string{
+  "sqlite3": "sqlite",
+  "postgresql": "postgres",
+  "pq": "postgres",
+}
+
+// Lookup returns the driver for a name from the config.
+func Lookup(name string) (*Driver, error) {
+  if alias, ok := aliases[name]; ok {
+    name = alias
+  }
+  if d, ok := Drivers[name]; ok {
+    return d, nil
+  }
+  if name == "" {
+    return nil, fmt.Errorf("db: no database driver is configured, set database.driver in the app's config")
+  }
+  names := make([]string, 0)
+  for n := range Drivers {
+    names = append(names, n)
+  }
+  sort.Strings(names)
+  return nil, fmt.Errorf("db: unknown driver %q, use one of %v", name, strings.Join(names, ", "))
+}
+
+// Open connects to a database and checks that it's reachable.
+func Open(driver string, dsn string) (*sql.DB, error) {
+  d, err := Lookup(driver)
+  if err != nil {
+    return nil, err
+  }
+  conn, err := sql.Open(d.Name, dsn)
+  if err != nil {
+    return nil, err
+  }
+  if err := conn.Ping(); err != nil {
+    conn.Close()
+    return nil, fmt.Errorf("db: %v", err)
+  }
+  return conn, nil
+}
+
+// Querier is a *sql.DB or a *sql.Tx.
+type Querier interface {
+  Exec(query string, args ...interface{}) (sql.Result, error)
+  Query(query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// Dialect is the SQL a database speaks, where it matters to eg.
+type Dialect int
+
+const (
+  SQLite Dialect = iota
+  Postgres
+)
+
+// DialectOf returns the dialect of an open database.
+func DialectOf(conn *sql.DB) Dialect {
+  if strings.Contains(fmt.Sprintf("%T", conn.Driver()), "pq.") {
+    return Postgres
+  }
+  return SQLite
+}
+
+// Placeholder returns the placeholder of the nth argument of a query,
+// counting from 1.
+func (d Dialect) Placeholder(n int) string {
+  if d == Postgres {
+    return fmt.Sprintf("$%v", n)
+  }
+  return "?"
+}
+
+// Quote quotes an identifier such as a table or column name.
+func (d Dialect) Quote(name string) string {
+  return `"` + strings.Replace(name, `"`, `""`, -1) + `"`
+}
+
+// References returns the tables the foreign keys of table point to.
+func (d Dialect) References(q Querier, table string) ([]string, error) {
+  var query string
+  var args []interface{}
+  if d == Postgres {
+    query = `SELECT DISTINCT ccu.table_name
+      FROM information_schema.table_constraints tc
+      JOIN information_schema.constraint_column_usage ccu
+        ON tc.constraint_name = ccu.constraint_name AND tc.table_schema = ccu.table_schema
+      WHERE tc.constraint_type = 'FOREIGN KEY' AND tc.table_name = $1`
+    args = []interface{}{table}
+  } else {
+    query = fmt.Sprintf(`SELECT DISTINCT "table" FROM pragma_foreign_key_list(%v)`, quoteString(table))
+  }
+  rows, err := q.Query(query, args...)
+  if err != nil {
+    return nil, err
+  }
+  defer rows.Close()
+  refs := make([]string, 0)
+  for rows.Next() {
+    var ref string
+    if err := rows.Scan(&ref); err != nil {
+      return nil, err
+    }
+    refs = append(refs, ref)
+  }
+  return refs, rows.Err()
+}
+
+func quoteString(s string) string {
+  return "'" + strings.Replace(s, "'", "''", -1) + "'"
+}
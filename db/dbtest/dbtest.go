@@ -0,0 +1,59 @@
+// Package dbtest provides test helpers for apps with a database: each test
+// gets a transaction with the YAML fixtures loaded, which is rolled back when
+// the test ends so tests don't see each other's data.
+package dbtest
+
+import (
+  "database/sql"
+  "os"
+  "testing"
+  "github.com/murz/eg/db"
+  // Only test binaries link dbtest, so it registers every driver eg knows,
+  // whichever one `eg test` is configured with.
+  _ "github.com/lib/pq"
+  _ "modernc.org/sqlite"
+)
+
+// Load begins a transaction on conn and loads the fixtures in dir into it, in
+// dependency order. The transaction is rolled back when t ends.
+func Load(t testing.TB, conn *sql.DB, dir string) *sql.Tx {
+  t.Helper()
+  tables, err := db.LoadDir(dir)
+  if err != nil {
+    t.Fatal(err)
+  }
+  tx, err := conn.Begin()
+  if err != nil {
+    t.Fatal(err)
+  }
+  t.Cleanup(func() {
+    tx.Rollback()
+  })
+  if _, err := db.Fill(tx, db.DialectOf(conn), tables); err != nil {
+    t.Fatal(err)
+  }
+  return tx
+}
+
+// Open connects to the database in EGO_DB_DRIVER and EGO_DB_DSN, which
+// `eg test` sets from the test section of the config, or else to an in-memory
+// SQLite database. The connection is closed when t ends.
+func Open(t testing.TB) *sql.DB {
+  t.Helper()
+  driver, dsn := os.Getenv("EGO_DB_DRIVER"), os.Getenv("EGO_DB_DSN")
+  if driver == "" {
+    driver, dsn = "sqlite", ":memory:"
+  }
+  conn, err := db.Open(driver, dsn)
+  if err != nil {
+    t.Fatal(err)
+  }
+  if dsn == ":memory:" {
+    // Each connection would get its own empty database.
+    conn.SetMaxOpenConns(1)
+  }
+  t.Cleanup(func() {
+    conn.Close()
+  })
+  return conn
+}
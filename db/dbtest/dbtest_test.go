@@ -0,0 +1,29 @@
+package dbtest
+
+import (
+  "io/ioutil"
+  "path/filepath"
+  "testing"
+)
+
+func TestLoadRollsBack(t *testing.T) {
+  conn := Open(t)
+  if _, err := conn.Exec("CREATE TABLE users (id INTEGER PRIMARY KEY, name TEXT)"); err != nil {
+    t.Fatal(err)
+  }
+  dir := t.TempDir()
+  ioutil.WriteFile(filepath.Join(dir, "users.yml"), []byte("- name: Ann\n- name: Bob\n"), 0666)
+
+  t.Run("loads", func(t *testing.T) {
+    tx := Load(t, conn, dir)
+    var n int
+    if err := tx.QueryRow("SELECT count(*) FROM users").Scan(&n); err != nil || n != 2 {
+      t.Errorf("count = %v, %v", n, err)
+    }
+  })
+
+  var n int
+  if err := conn.QueryRow("SELECT count(*) FROM users").Scan(&n); err != nil || n != 0 {
+    t.Errorf("count after the test = %v, %v, want the fixtures rolled back", n, err)
+  }
+}
@@ -0,0 +1,278 @@
+package db
+
+import (
+  "fmt"
+  "io/ioutil"
+  "os"
+  "path/filepath"
+  "reflect"
+  "strings"
+  "time"
+  "unicode"
+)
+
+// MigrationsDir is where `eg db diff` writes migrations.
+const MigrationsDir = "db/migrations"
+
+// Model is a struct of app/models. Its table is the plural of its name in
+// snake case and its columns are its fields, named with a `db` tag or in
+// snake case. A tag can also ask for an index, `db:"email,unique"` or
+// `db:"created_at,index"`, and `db:"-"` leaves a field out. Fields of types
+// with no column type, such as structs of relations, are left out too.
+type Model struct {
+  Name string
+  Fields []Field
+}
+
+type Field struct {
+  Name string
+  Type string
+  Tag string
+}
+
+// Schema returns the table m describes, or nil if it has no columns.
+func (d Dialect) Schema(m Model) *Schema {
+  s := &Schema{Name: TableName(m.Name)}
+  for _, f := range m.Fields {
+    opts := strings.Split(reflect.StructTag(f.Tag).Get("db"), ",")
+    if opts[0] == "-" {
+      continue
+    }
+    typ, ok := d.ColumnType(f.Type)
+    if !ok {
+      continue
+    }
+    c := &Column{Name: opts[0], Type: typ}
+    if c.Name == "" {
+      c.Name = snakeCase(f.Name)
+    }
+    if f.Name == "ID" && family(typ) == "integer" {
+      c.PrimaryKey = true
+      if d == Postgres {
+        c.Type = "BIGSERIAL"
+      }
+    }
+    s.Columns = append(s.Columns, c)
+    for _, opt := range opts[1:] {
+      if opt == "index" || opt == "unique" {
+        s.Indexes = append(s.Indexes, &Index{
+          Name: fmt.Sprintf("idx_%v_%v", s.Name, c.Name),
+          Columns: []string{c.Name},
+          Unique: opt == "unique",
+        })
+      }
+    }
+  }
+  if len(s.Columns) == 0 {
+    return nil
+  }
+  return s
+}
+
+// ColumnType returns the column type of a Go type, as written in the source.
+func (d Dialect) ColumnType(goType string) (string, bool) {
+  goType = strings.TrimPrefix(goType, "*")
+  pg := d == Postgres
+  switch goType {
+  case "int", "int64", "uint", "uint64", "sql.NullInt64":
+    if pg {
+      return "BIGINT", true
+    }
+    return "INTEGER", true
+  case "int8", "int16", "int32", "uint8", "uint16", "uint32", "sql.NullInt32", "sql.NullInt16":
+    return "INTEGER", true
+  case "string", "sql.NullString":
+    return "TEXT", true
+  case "bool", "sql.NullBool":
+    return "BOOLEAN", true
+  case "float32", "float64", "sql.NullFloat64":
+    if pg {
+      return "DOUBLE PRECISION", true
+    }
+    return "REAL", true
+  case "time.Time", "sql.NullTime":
+    if pg {
+      return "TIMESTAMP", true
+    }
+    return "DATETIME", true
+  case "[]byte":
+    if pg {
+      return "BYTEA", true
+    }
+    return "BLOB", true
+  }
+  return "", false
+}
+
+// family groups the column types that store the same kind of value, so
+// INT and BIGINT, or VARCHAR(255) and TEXT, aren't told apart.
+func family(typ string) string {
+  t := strings.ToLower(typ)
+  if i := strings.IndexByte(t, '('); i >= 0 {
+    t = strings.TrimSpace(t[:i])
+  }
+  switch {
+  case strings.Contains(t, "int") || strings.Contains(t, "serial"):
+    return "integer"
+  case strings.Contains(t, "char") || strings.Contains(t, "text") || strings.Contains(t, "clob"):
+    return "text"
+  case strings.Contains(t, "bool"):
+    return "boolean"
+  case strings.Contains(t, "real") || strings.Contains(t, "floa") || strings.Contains(t, "doub") ||
+    strings.Contains(t, "numeric") || strings.Contains(t, "decimal"):
+    return "real"
+  case strings.Contains(t, "time") || strings.Contains(t, "date"):
+    return "timestamp"
+  case strings.Contains(t, "blob") || strings.Contains(t, "bytea"):
+    return "blob"
+  }
+  return t
+}
+
+// Diff returns the statements that change the live tables into the ones
+// the models describe: new tables, added columns, changed column types and
+// new indexes. Nothing is dropped; tables and columns the models don't have
+// are reported in notes instead.
+func (d Dialect) Diff(models []*Schema, live []*Schema) (statements []string, notes []string) {
+  liveByName := make(map[string]*Schema)
+  for _, s := range live {
+    liveByName[s.Name] = s
+  }
+  modelByName := make(map[string]*Schema)
+  for _, m := range models {
+    modelByName[m.Name] = m
+    l := liveByName[m.Name]
+    if l == nil {
+      statements = append(statements, d.CreateTable(m))
+      for _, i := range m.Indexes {
+        statements = append(statements, d.CreateIndex(m.Name, i))
+      }
+      continue
+    }
+
+    changed := make([]*Column, 0)
+    for _, c := range m.Columns {
+      lc := l.Column(c.Name)
+      if lc == nil {
+        statements = append(statements, fmt.Sprintf("ALTER TABLE %v ADD COLUMN %v", d.Quote(m.Name), d.columnDef(c)))
+      } else if family(lc.Type) != family(c.Type) {
+        changed = append(changed, c)
+      }
+    }
+    if len(changed) > 0 {
+      if d == Postgres {
+        for _, c := range changed {
+          statements = append(statements, fmt.Sprintf("ALTER TABLE %v ALTER COLUMN %v TYPE %v USING %v::%v",
+            d.Quote(m.Name), d.Quote(c.Name), c.Type, d.Quote(c.Name), c.Type))
+        }
+      } else {
+        statements = append(statements, d.rebuild(m, l)...)
+      }
+    }
+    for _, i := range m.Indexes {
+      if !l.HasIndex(i.Columns) {
+        statements = append(statements, d.CreateIndex(m.Name, i))
+      }
+    }
+    for _, lc := range l.Columns {
+      if m.Column(lc.Name) == nil {
+        notes = append(notes, fmt.Sprintf("%v.%v isn't a field of the models", l.Name, lc.Name))
+      }
+    }
+  }
+  for _, l := range live {
+    if modelByName[l.Name] == nil {
+      notes = append(notes, fmt.Sprintf("%v isn't the table of a model", l.Name))
+    }
+  }
+  return statements, notes
+}
+
+// rebuild returns the statements that change column types in SQLite, which
+// can't alter them: the table is copied into a new one with the model's
+// types, keeping the columns the model doesn't have, and its indexes are
+// created again.
+func (d Dialect) rebuild(m *Schema, l *Schema) []string {
+  s := &Schema{Name: m.Name + "_new"}
+  common := make([]string, 0)
+  for _, lc := range l.Columns {
+    c := m.Column(lc.Name)
+    if c == nil {
+      c = lc
+    } else if lc.References != "" && c.References == "" {
+      c = &Column{Name: c.Name, Type: c.Type, PrimaryKey: c.PrimaryKey, References: lc.References}
+    }
+    s.Columns = append(s.Columns, c)
+    common = append(common, d.Quote(c.Name))
+  }
+  for _, c := range m.Columns {
+    if l.Column(c.Name) == nil {
+      s.Columns = append(s.Columns, c)
+    }
+  }
+  statements := []string{
+    d.CreateTable(s),
+    fmt.Sprintf("INSERT INTO %v (%v) SELECT %v FROM %v", d.Quote(s.Name),
+      strings.Join(common, ", "), strings.Join(common, ", "), d.Quote(l.Name)),
+    fmt.Sprintf("DROP TABLE %v", d.Quote(l.Name)),
+    fmt.Sprintf("ALTER TABLE %v RENAME TO %v", d.Quote(s.Name), d.Quote(l.Name)),
+  }
+  for _, i := range l.Indexes {
+    statements = append(statements, d.CreateIndex(l.Name, i))
+  }
+  return statements
+}
+
+// WriteMigration writes statements to a new migration in dir, named with the
+// time and name, and returns its filename.
+func WriteMigration(dir string, name string, statements []string, notes []string) (string, error) {
+  if err := os.MkdirAll(dir, 0777); err != nil {
+    return "", err
+  }
+  var b strings.Builder
+  for _, n := range notes {
+    fmt.Fprintf(&b, "-- %v\n", n)
+  }
+  if len(notes) > 0 {
+    b.WriteString("\n")
+  }
+  for _, s := range statements {
+    b.WriteString(s + ";\n\n")
+  }
+  filename := filepath.Join(dir, fmt.Sprintf("%v_%v.sql", time.Now().UTC().Format("20060102150405"), snakeCase(name)))
+  return filename, ioutil.WriteFile(filename, []byte(strings.TrimRight(b.String(), "\n") + "\n"), 0666)
+}
+
+// TableName returns the table of a model: Post is posts, BlogCategory is
+// blog_categories.
+func TableName(model string) string {
+  name := snakeCase(model)
+  switch {
+  case strings.HasSuffix(name, "y") && len(name) > 1 && !strings.ContainsRune("aeiou", rune(name[len(name) - 2])):
+    return name[:len(name) - 1] + "ies"
+  case strings.HasSuffix(name, "s") || strings.HasSuffix(name, "x") || strings.HasSuffix(name, "z") ||
+    strings.HasSuffix(name, "ch") || strings.HasSuffix(name, "sh"):
+    return name + "es"
+  }
+  return name + "s"
+}
+
+// snakeCase turns CreatedAt into created_at and UserID into user_id.
+func snakeCase(name string) string {
+  runes := []rune(name)
+  var b strings.Builder
+  for i, r := range runes {
+    if unicode.IsUpper(r) && i > 0 {
+      prev := runes[i - 1]
+      nextLower := i + 1 < len(runes) && unicode.IsLower(runes[i + 1])
+      if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+        b.WriteByte('_')
+      }
+    }
+    if r == '-' || r == ' ' {
+      r = '_'
+    }
+    b.WriteRune(unicode.ToLower(r))
+  }
+  return b.String()
+}
@@ -0,0 +1,119 @@
+package db
+
+import (
+  "io/ioutil"
+  "path/filepath"
+  "reflect"
+  "strings"
+  "testing"
+)
+
+var post = Model{
+  Name: "Post",
+  Fields: []Field{
+    {Name: "ID", Type: "int"},
+    {Name: "Title", Type: "string"},
+    {Name: "Tags", Type: "int"},
+    {Name: "UserID", Type: "int64"},
+    {Name: "PublishedAt", Type: "*time.Time", Tag: `db:"published_at,index"`},
+    {Name: "Slug", Type: "string", Tag: `json:"slug" db:",unique"`},
+    {Name: "Draft", Type: "bool", Tag: `db:"-"`},
+    {Name: "Author", Type: "User"},
+  },
+}
+
+func TestSchema(t *testing.T) {
+  s := Postgres.Schema(post)
+  got := Postgres.CreateTable(s)
+  want := `CREATE TABLE "posts" (
+  "id" BIGSERIAL PRIMARY KEY,
+  "title" TEXT,
+  "tags" BIGINT,
+  "user_id" BIGINT,
+  "published_at" TIMESTAMP,
+  "slug" TEXT
+)`
+  if got != want {
+    t.Errorf("CreateTable =\n%v\nwant\n%v", got, want)
+  }
+  if len(s.Indexes) != 2 || s.Indexes[0].Name != "idx_posts_published_at" || !s.Indexes[1].Unique {
+    t.Errorf("indexes = %+v, want published_at and a unique slug", s.Indexes)
+  }
+  if s := SQLite.Schema(Model{Name: "Thing", Fields: []Field{{Name: "Owner", Type: "User"}}}); s != nil {
+    t.Errorf("Schema of a model without columns = %+v, want nil", s)
+  }
+}
+
+func TestTableName(t *testing.T) {
+  for model, want := range map[string]string{
+    "Post": "posts",
+    "BlogCategory": "blog_categories",
+    "Day": "days",
+    "Box": "boxes",
+    "HTMLPage": "html_pages",
+  } {
+    if got := TableName(model); got != want {
+      t.Errorf("TableName(%v) = %v, want %v", model, got, want)
+    }
+  }
+}
+
+func TestDiff(t *testing.T) {
+  conn := openSchema(t)
+  live, err := SQLite.ReadSchema(conn)
+  if err != nil {
+    t.Fatal(err)
+  }
+  models := []*Schema{
+    SQLite.Schema(post),
+    SQLite.Schema(Model{Name: "Tag", Fields: []Field{{Name: "ID", Type: "int"}, {Name: "Name", Type: "string", Tag: `db:"name,index"`}}}),
+    SQLite.Schema(Model{Name: "User", Fields: []Field{{Name: "ID", Type: "int"}, {Name: "Name", Type: "string"}}}),
+  }
+  statements, notes := SQLite.Diff(models, live)
+  if want := []string{"comments isn't the table of a model"}; !reflect.DeepEqual(notes, want) {
+    t.Errorf("notes = %q, want %q", notes, want)
+  }
+
+  // The migration applies, changes the type of posts.tags by copying the
+  // table and leaves the database matching the models.
+  if _, err := conn.Exec("INSERT INTO posts (title, tags) VALUES ('Hello', '3')"); err != nil {
+    t.Fatal(err)
+  }
+  for _, s := range statements {
+    if _, err := conn.Exec(s); err != nil {
+      t.Fatalf("%v: %v", s, err)
+    }
+  }
+  live, _ = SQLite.ReadSchema(conn)
+  if statements, _ := SQLite.Diff(models, live); len(statements) != 0 {
+    t.Errorf("Diff after migrating = %q, want none", statements)
+  }
+  var tags int
+  var title string
+  if err := conn.QueryRow("SELECT title, tags FROM posts").Scan(&title, &tags); err != nil || title != "Hello" || tags != 3 {
+    t.Errorf("post = %v, %v, %v, want the row kept", title, tags, err)
+  }
+  if c := live[1].Column("user_id"); live[1].Name != "posts" || c == nil || c.References != "users.id" {
+    t.Errorf("posts.user_id = %+v, want its foreign key kept", c)
+  }
+
+  filename, err := WriteMigration(t.TempDir(), "AddTags", statements, notes)
+  if err != nil {
+    t.Fatal(err)
+  }
+  migration, _ := ioutil.ReadFile(filename)
+  if !strings.HasSuffix(filename, "_add_tags.sql") || !strings.HasPrefix(string(migration), "-- comments isn't the table of a model\n\nALTER TABLE") {
+    t.Errorf("%v =\n%s", filepath.Base(filename), migration)
+  }
+  for _, want := range []string{
+    `ALTER TABLE "posts" ADD COLUMN "published_at" DATETIME;`,
+    `INSERT INTO "posts_new" ("id", "title", "tags", "user_id") SELECT "id", "title", "tags", "user_id" FROM "posts";`,
+    `CREATE INDEX "idx_posts_published_at" ON "posts" ("published_at");`,
+    `CREATE UNIQUE INDEX "idx_posts_slug" ON "posts" ("slug");`,
+    "CREATE TABLE \"tags\" (\n  \"id\" INTEGER PRIMARY KEY,\n  \"name\" TEXT\n);",
+  } {
+    if !strings.Contains(string(migration), want) {
+      t.Errorf("migration doesn't contain %v:\n%s", want, migration)
+    }
+  }
+}
@@ -0,0 +1,225 @@
+package db
+
+import (
+  "database/sql"
+  "encoding/json"
+  "fmt"
+  "io/ioutil"
+  "os"
+  "path/filepath"
+  "sort"
+  "strings"
+  "gopkg.in/yaml.v3"
+)
+
+// SeedsDir holds the seeds `eg db seed` loads, one YAML file per table.
+const SeedsDir = "db/seeds"
+
+// FixturesDir is where the test helpers look for fixtures by default.
+const FixturesDir = "db/fixtures"
+
+// Table is the rows of a seed or fixture file, which is named after the table.
+// A file is either a list of rows, or rows by label, loaded in label order:
+//
+//   hello:
+//     title: Hello
+//     user_id: 1
+type Table struct {
+  Name string
+  File string
+  Rows []map[string]interface{}
+}
+
+// IsFile reports whether filename is a seed or fixture file.
+func IsFile(filename string) bool {
+  ext := filepath.Ext(filename)
+  return ext == ".yml" || ext == ".yaml"
+}
+
+// LoadDir reads the tables in dir, sorted by name. A missing dir has none.
+func LoadDir(dir string) ([]*Table, error) {
+  dirlist, err := ioutil.ReadDir(dir)
+  if os.IsNotExist(err) {
+    return []*Table{}, nil
+  }
+  if err != nil {
+    return nil, err
+  }
+  tables := make([]*Table, 0)
+  for _, f := range dirlist {
+    if f.IsDir() || !IsFile(f.Name()) {
+      continue
+    }
+    t, err := LoadFile(filepath.Join(dir, f.Name()))
+    if err != nil {
+      return nil, err
+    }
+    tables = append(tables, t)
+  }
+  return tables, nil
+}
+
+// LoadFile reads the rows of a table.
+func LoadFile(filename string) (*Table, error) {
+  data, err := ioutil.ReadFile(filename)
+  if err != nil {
+    return nil, err
+  }
+  t := &Table{
+    Name: strings.TrimSuffix(filepath.Base(filename), filepath.Ext(filename)),
+    File: filename,
+    Rows: make([]map[string]interface{}, 0),
+  }
+  var doc interface{}
+  if err := yaml.Unmarshal(data, &doc); err != nil {
+    return nil, fmt.Errorf("%v: %v", filename, err)
+  }
+  switch rows := doc.(type) {
+  case nil:
+  case []interface{}:
+    for i, row := range rows {
+      m, ok := row.(map[string]interface{})
+      if !ok {
+        return nil, fmt.Errorf("%v: row %v isn't a map of columns", filename, i + 1)
+      }
+      t.Rows = append(t.Rows, m)
+    }
+  case map[string]interface{}:
+    labels := make([]string, 0, len(rows))
+    for label := range rows {
+      labels = append(labels, label)
+    }
+    sort.Strings(labels)
+    for _, label := range labels {
+      m, ok := rows[label].(map[string]interface{})
+      if !ok {
+        return nil, fmt.Errorf("%v: %v isn't a map of columns", filename, label)
+      }
+      t.Rows = append(t.Rows, m)
+    }
+  default:
+    return nil, fmt.Errorf("%v: expected a list of rows or rows by label", filename)
+  }
+  return t, nil
+}
+
+// Order sorts tables so the ones a table's foreign keys point to come before
+// it. Otherwise the tables keep their order.
+func Order(q Querier, d Dialect, tables []*Table) ([]*Table, error) {
+  byName := make(map[string]*Table)
+  for _, t := range tables {
+    byName[t.Name] = t
+  }
+  deps := make(map[string][]string)
+  for _, t := range tables {
+    refs, err := d.References(q, t.Name)
+    if err != nil {
+      return nil, fmt.Errorf("%v: %v", t.File, err)
+    }
+    for _, ref := range refs {
+      if ref != t.Name && byName[ref] != nil {
+        deps[t.Name] = append(deps[t.Name], ref)
+      }
+    }
+  }
+
+  ordered := make([]*Table, 0, len(tables))
+  done := make(map[string]bool)
+  visiting := make(map[string]bool)
+  var visit func(t *Table) error
+  visit = func(t *Table) error {
+    if done[t.Name] {
+      return nil
+    }
+    if visiting[t.Name] {
+      return fmt.Errorf("db: the foreign keys of %v form a cycle", t.Name)
+    }
+    visiting[t.Name] = true
+    for _, dep := range deps[t.Name] {
+      if err := visit(byName[dep]); err != nil {
+        return err
+      }
+    }
+    visiting[t.Name] = false
+    done[t.Name] = true
+    ordered = append(ordered, t)
+    return nil
+  }
+  for _, t := range tables {
+    if err := visit(t); err != nil {
+      return nil, err
+    }
+  }
+  return ordered, nil
+}
+
+// Insert adds the rows of t to its table.
+func Insert(q Querier, d Dialect, t *Table) error {
+  for i, row := range t.Rows {
+    columns := make([]string, 0, len(row))
+    for c := range row {
+      columns = append(columns, c)
+    }
+    sort.Strings(columns)
+    names := make([]string, len(columns))
+    placeholders := make([]string, len(columns))
+    args := make([]interface{}, len(columns))
+    for j, c := range columns {
+      names[j] = d.Quote(c)
+      placeholders[j] = d.Placeholder(j + 1)
+      v, err := value(row[c])
+      if err != nil {
+        return fmt.Errorf("%v: row %v: %v: %v", t.File, i + 1, c, err)
+      }
+      args[j] = v
+    }
+    query := fmt.Sprintf("INSERT INTO %v (%v) VALUES (%v)", d.Quote(t.Name), strings.Join(names, ", "), strings.Join(placeholders, ", "))
+    if _, err := q.Exec(query, args...); err != nil {
+      return fmt.Errorf("%v: row %v: %v", t.File, i + 1, err)
+    }
+  }
+  return nil
+}
+
+// value converts a YAML value for a column. Lists and maps are stored as JSON.
+func value(v interface{}) (interface{}, error) {
+  switch v.(type) {
+  case []interface{}, map[string]interface{}:
+    data, err := json.Marshal(v)
+    return string(data), err
+  }
+  return v, nil
+}
+
+// Fill inserts the tables into the database in dependency order, returning
+// the order they were loaded in.
+func Fill(q Querier, d Dialect, tables []*Table) ([]*Table, error) {
+  ordered, err := Order(q, d, tables)
+  if err != nil {
+    return nil, err
+  }
+  for _, t := range ordered {
+    if err := Insert(q, d, t); err != nil {
+      return nil, err
+    }
+  }
+  return ordered, nil
+}
+
+// Seed loads the tables in dir in one transaction.
+func Seed(conn *sql.DB, dir string) ([]*Table, error) {
+  tables, err := LoadDir(dir)
+  if err != nil {
+    return nil, err
+  }
+  tx, err := conn.Begin()
+  if err != nil {
+    return nil, err
+  }
+  ordered, err := Fill(tx, DialectOf(conn), tables)
+  if err != nil {
+    tx.Rollback()
+    return nil, err
+  }
+  return ordered, tx.Commit()
+}
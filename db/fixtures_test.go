@@ -0,0 +1,119 @@
+package db
+
+import (
+  "database/sql"
+  "io/ioutil"
+  "path/filepath"
+  "strings"
+  "testing"
+  _ "modernc.org/sqlite"
+)
+
+func writeFiles(t *testing.T, files map[string]string) string {
+  dir := t.TempDir()
+  for name, content := range files {
+    if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0666); err != nil {
+      t.Fatal(err)
+    }
+  }
+  return dir
+}
+
+func openSchema(t *testing.T) *sql.DB {
+  conn, err := Open("sqlite", ":memory:")
+  if err != nil {
+    t.Fatal(err)
+  }
+  conn.SetMaxOpenConns(1)
+  t.Cleanup(func() {
+    conn.Close()
+  })
+  for _, stmt := range []string{
+    "PRAGMA foreign_keys = ON",
+    "CREATE TABLE users (id INTEGER PRIMARY KEY, name TEXT)",
+    "CREATE TABLE posts (id INTEGER PRIMARY KEY, title TEXT, tags TEXT, user_id INTEGER REFERENCES users(id))",
+    "CREATE TABLE comments (id INTEGER PRIMARY KEY, body TEXT, post_id INTEGER REFERENCES posts(id), user_id INTEGER REFERENCES users(id))",
+  } {
+    if _, err := conn.Exec(stmt); err != nil {
+      t.Fatal(err)
+    }
+  }
+  return conn
+}
+
+func TestLoadFile(t *testing.T) {
+  dir := writeFiles(t, map[string]string{
+    "posts.yml": "- title: First\n  tags: [a, b]\n- title: Second\n",
+    "users.yaml": "bob:\n  name: Bob\nann:\n  name: Ann\n",
+    "empty.yml": "",
+    "broken.yml": "- just a string\n",
+    "README": "not a table",
+  })
+  if _, err := LoadDir(dir); err == nil || !strings.Contains(err.Error(), "broken.yml: row 1") {
+    t.Errorf("LoadDir = %v, want an error for broken.yml", err)
+  }
+
+  users, err := LoadFile(filepath.Join(dir, "users.yaml"))
+  if err != nil {
+    t.Fatal(err)
+  }
+  if users.Name != "users" || len(users.Rows) != 2 || users.Rows[0]["name"] != "Ann" {
+    t.Errorf("users = %+v, want the rows in label order", users)
+  }
+  empty, err := LoadFile(filepath.Join(dir, "empty.yml"))
+  if err != nil || len(empty.Rows) != 0 {
+    t.Errorf("empty = %+v, %v", empty, err)
+  }
+}
+
+func TestSeedInDependencyOrder(t *testing.T) {
+  conn := openSchema(t)
+  dir := writeFiles(t, map[string]string{
+    "comments.yml": "- id: 1\n  body: Nice\n  post_id: 1\n  user_id: 2\n",
+    "posts.yml": "- id: 1\n  title: First\n  tags: [a, b]\n  user_id: 1\n",
+    "users.yml": "ann:\n  id: 1\n  name: Ann\nbob:\n  id: 2\n  name: Bob\n",
+  })
+  tables, err := Seed(conn, dir)
+  if err != nil {
+    t.Fatal(err)
+  }
+  names := make([]string, 0)
+  for _, table := range tables {
+    names = append(names, table.Name)
+  }
+  if strings.Join(names, ",") != "users,posts,comments" {
+    t.Errorf("seeded %v, want users before posts before comments", names)
+  }
+  var tags string
+  if err := conn.QueryRow("SELECT tags FROM posts WHERE id = 1").Scan(&tags); err != nil || tags != `["a","b"]` {
+    t.Errorf("tags = %q, %v", tags, err)
+  }
+}
+
+func TestSeedRollsBackOnError(t *testing.T) {
+  conn := openSchema(t)
+  dir := writeFiles(t, map[string]string{
+    "users.yml": "- id: 1\n  name: Ann\n",
+    "posts.yml": "- id: 1\n  title: First\n  author: nobody\n",
+  })
+  if _, err := Seed(conn, dir); err == nil || !strings.Contains(err.Error(), "posts.yml: row 1") {
+    t.Errorf("Seed = %v, want an error for the unknown column", err)
+  }
+  var n int
+  conn.QueryRow("SELECT count(*) FROM users").Scan(&n)
+  if n != 0 {
+    t.Errorf("%v users were left behind", n)
+  }
+}
+
+func TestLookup(t *testing.T) {
+  if d, err := Lookup("sqlite3"); err != nil || d.Name != "sqlite" {
+    t.Errorf("Lookup(sqlite3) = %v, %v", d, err)
+  }
+  if _, err := Lookup("oracle"); err == nil || !strings.Contains(err.Error(), "postgres, sqlite") {
+    t.Errorf("Lookup(oracle) = %v", err)
+  }
+  if Postgres.Placeholder(2) != "$2" || SQLite.Placeholder(2) != "?" {
+    t.Errorf("wrong placeholders")
+  }
+}
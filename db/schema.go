@@ -0,0 +1,443 @@
+package db
+
+import (
+  "database/sql"
+  "fmt"
+  "io/ioutil"
+  "regexp"
+  "strings"
+)
+
+// SchemaFile is where `eg db schema:dump` writes the schema.
+const SchemaFile = "db/schema.sql"
+
+// Schema is a table of a database, or the one a model describes.
+type Schema struct {
+  Name string
+  Columns []*Column
+  Indexes []*Index
+}
+
+type Column struct {
+  Name string
+  Type string
+  PrimaryKey bool
+  // References is the "table.column" of a foreign key, if the column is one.
+  References string
+}
+
+type Index struct {
+  Name string
+  Columns []string
+  Unique bool
+}
+
+// Column returns the column of s named name, or nil.
+func (s *Schema) Column(name string) *Column {
+  for _, c := range s.Columns {
+    if c.Name == name {
+      return c
+    }
+  }
+  return nil
+}
+
+// HasIndex reports whether s has an index on exactly columns.
+func (s *Schema) HasIndex(columns []string) bool {
+  for _, i := range s.Indexes {
+    if strings.Join(i.Columns, ",") == strings.Join(columns, ",") {
+      return true
+    }
+  }
+  return false
+}
+
+// Tables returns the names of the app's tables, sorted.
+func (d Dialect) Tables(q Querier) ([]string, error) {
+  query := `SELECT name FROM sqlite_master
+    WHERE type = 'table' AND name NOT LIKE 'sqlite_%' ORDER BY name`
+  if d == Postgres {
+    query = `SELECT table_name FROM information_schema.tables
+      WHERE table_schema = current_schema() AND table_type = 'BASE TABLE' ORDER BY table_name`
+  }
+  return strings1(q, query)
+}
+
+// ReadSchema returns the tables of a database, sorted by name.
+func (d Dialect) ReadSchema(q Querier) ([]*Schema, error) {
+  names, err := d.Tables(q)
+  if err != nil {
+    return nil, err
+  }
+  schemas := make([]*Schema, 0, len(names))
+  for _, name := range names {
+    s := &Schema{Name: name}
+    if d == Postgres {
+      err = readPostgres(q, s)
+    } else {
+      err = readSQLite(q, s)
+    }
+    if err != nil {
+      return nil, fmt.Errorf("db: reading %v: %v", name, err)
+    }
+    schemas = append(schemas, s)
+  }
+  return schemas, nil
+}
+
+func readSQLite(q Querier, s *Schema) error {
+  rows, err := q.Query(fmt.Sprintf("SELECT name, type, pk FROM pragma_table_info(%v) ORDER BY cid", quoteString(s.Name)))
+  if err != nil {
+    return err
+  }
+  for rows.Next() {
+    c := &Column{}
+    var pk int
+    if err := rows.Scan(&c.Name, &c.Type, &pk); err != nil {
+      rows.Close()
+      return err
+    }
+    c.PrimaryKey = pk > 0
+    s.Columns = append(s.Columns, c)
+  }
+  rows.Close()
+  if err := rows.Err(); err != nil {
+    return err
+  }
+
+  fks, err := strings1(q, fmt.Sprintf(
+    `SELECT "from" || ' ' || "table" || '.' || "to" FROM pragma_foreign_key_list(%v)`, quoteString(s.Name)))
+  if err != nil {
+    return err
+  }
+  setReferences(s, fks)
+
+  // Indexes SQLite makes for primary keys and constraints aren't the app's.
+  rows, err = q.Query(fmt.Sprintf(
+    `SELECT name, "unique" FROM pragma_index_list(%v) WHERE origin = 'c' ORDER BY name`, quoteString(s.Name)))
+  if err != nil {
+    return err
+  }
+  for rows.Next() {
+    i := &Index{}
+    if err := rows.Scan(&i.Name, &i.Unique); err != nil {
+      rows.Close()
+      return err
+    }
+    s.Indexes = append(s.Indexes, i)
+  }
+  rows.Close()
+  if err := rows.Err(); err != nil {
+    return err
+  }
+  for _, i := range s.Indexes {
+    i.Columns, err = strings1(q, fmt.Sprintf("SELECT name FROM pragma_index_info(%v) ORDER BY seqno", quoteString(i.Name)))
+    if err != nil {
+      return err
+    }
+  }
+  return nil
+}
+
+var indexDefRegexp = regexp.MustCompile(`^CREATE (UNIQUE )?INDEX .* \((.+)\)$`)
+
+func readPostgres(q Querier, s *Schema) error {
+  rows, err := q.Query(`SELECT c.column_name, c.data_type, coalesce(c.column_default, ''),
+      EXISTS (SELECT 1 FROM information_schema.table_constraints tc
+        JOIN information_schema.key_column_usage k
+          ON tc.constraint_name = k.constraint_name AND tc.table_schema = k.table_schema
+        WHERE tc.constraint_type = 'PRIMARY KEY' AND tc.table_name = c.table_name
+          AND tc.table_schema = c.table_schema AND k.column_name = c.column_name)
+    FROM information_schema.columns c
+    WHERE c.table_schema = current_schema() AND c.table_name = $1
+    ORDER BY c.ordinal_position`, s.Name)
+  if err != nil {
+    return err
+  }
+  for rows.Next() {
+    c := &Column{}
+    var def string
+    if err := rows.Scan(&c.Name, &c.Type, &def, &c.PrimaryKey); err != nil {
+      rows.Close()
+      return err
+    }
+    if strings.HasPrefix(def, "nextval(") {
+      switch c.Type {
+      case "bigint":
+        c.Type = "bigserial"
+      case "integer":
+        c.Type = "serial"
+      }
+    }
+    s.Columns = append(s.Columns, c)
+  }
+  rows.Close()
+  if err := rows.Err(); err != nil {
+    return err
+  }
+
+  fks, err := strings1(q, `SELECT k.column_name || ' ' || ccu.table_name || '.' || ccu.column_name
+    FROM information_schema.table_constraints tc
+    JOIN information_schema.key_column_usage k
+      ON tc.constraint_name = k.constraint_name AND tc.table_schema = k.table_schema
+    JOIN information_schema.constraint_column_usage ccu
+      ON tc.constraint_name = ccu.constraint_name AND tc.table_schema = ccu.table_schema
+    WHERE tc.constraint_type = 'FOREIGN KEY' AND tc.table_schema = current_schema() AND tc.table_name = $1`, s.Name)
+  if err != nil {
+    return err
+  }
+  setReferences(s, fks)
+
+  rows, err = q.Query(`SELECT i.indexname, i.indexdef FROM pg_indexes i
+    WHERE i.schemaname = current_schema() AND i.tablename = $1
+      AND NOT EXISTS (SELECT 1 FROM information_schema.table_constraints tc
+        WHERE tc.constraint_name = i.indexname AND tc.table_schema = i.schemaname)
+    ORDER BY i.indexname`, s.Name)
+  if err != nil {
+    return err
+  }
+  defer rows.Close()
+  for rows.Next() {
+    var name, def string
+    if err := rows.Scan(&name, &def); err != nil {
+      return err
+    }
+    m := indexDefRegexp.FindStringSubmatch(def)
+    if m == nil {
+      continue
+    }
+    i := &Index{Name: name, Unique: m[1] != ""}
+    for _, c := range strings.Split(m[2], ",") {
+      i.Columns = append(i.Columns, strings.Trim(strings.TrimSpace(c), `"`))
+    }
+    s.Indexes = append(s.Indexes, i)
+  }
+  return rows.Err()
+}
+
+// setReferences sets the foreign keys of s from "column table.column" pairs.
+func setReferences(s *Schema, fks []string) {
+  for _, fk := range fks {
+    parts := strings.SplitN(fk, " ", 2)
+    if c := s.Column(parts[0]); c != nil && len(parts) == 2 {
+      c.References = parts[1]
+    }
+  }
+}
+
+// strings1 returns the first column of the rows of a query.
+func strings1(q Querier, query string, args ...interface{}) ([]string, error) {
+  rows, err := q.Query(query, args...)
+  if err != nil {
+    return nil, err
+  }
+  defer rows.Close()
+  values := make([]string, 0)
+  for rows.Next() {
+    var v string
+    if err := rows.Scan(&v); err != nil {
+      return nil, err
+    }
+    values = append(values, v)
+  }
+  return values, rows.Err()
+}
+
+// CreateTable returns the statement that creates the table of s.
+func (d Dialect) CreateTable(s *Schema) string {
+  defs := make([]string, len(s.Columns))
+  for i, c := range s.Columns {
+    defs[i] = d.columnDef(c)
+  }
+  return fmt.Sprintf("CREATE TABLE %v (\n  %v\n)", d.Quote(s.Name), strings.Join(defs, ",\n  "))
+}
+
+func (d Dialect) columnDef(c *Column) string {
+  def := d.Quote(c.Name) + " " + c.Type
+  if c.PrimaryKey {
+    def += " PRIMARY KEY"
+  }
+  if c.References != "" {
+    ref := strings.SplitN(c.References, ".", 2)
+    def += fmt.Sprintf(" REFERENCES %v (%v)", d.Quote(ref[0]), d.Quote(ref[len(ref) - 1]))
+  }
+  return def
+}
+
+// CreateIndex returns the statement that creates an index of table.
+func (d Dialect) CreateIndex(table string, i *Index) string {
+  columns := make([]string, len(i.Columns))
+  for j, c := range i.Columns {
+    columns[j] = d.Quote(c)
+  }
+  create := "CREATE INDEX"
+  if i.Unique {
+    create = "CREATE UNIQUE INDEX"
+  }
+  return fmt.Sprintf("%v %v ON %v (%v)", create, d.Quote(i.Name), d.Quote(table), strings.Join(columns, ", "))
+}
+
+// Dump returns the statements that recreate the tables and indexes of a
+// database. SQLite's are the ones the tables were created with, Postgres'
+// are built from its information schema, with columns, primary and foreign
+// keys and indexes but not defaults or other constraints.
+func (d Dialect) Dump(q Querier) (string, error) {
+  statements := make([]string, 0)
+  if d == SQLite {
+    var err error
+    statements, err = strings1(q, `SELECT sql FROM sqlite_master
+      WHERE sql IS NOT NULL AND name NOT LIKE 'sqlite_%'
+      ORDER BY type = 'table' DESC, tbl_name, name`)
+    if err != nil {
+      return "", err
+    }
+  } else {
+    schemas, err := d.ReadSchema(q)
+    if err != nil {
+      return "", err
+    }
+    // The tables a table references have to be created before it.
+    schemas, err = d.createOrder(q, schemas)
+    if err != nil {
+      return "", err
+    }
+    for _, s := range schemas {
+      statements = append(statements, d.CreateTable(s))
+    }
+    for _, s := range schemas {
+      for _, i := range s.Indexes {
+        statements = append(statements, d.CreateIndex(s.Name, i))
+      }
+    }
+  }
+  if len(statements) == 0 {
+    return "", nil
+  }
+  return strings.Join(statements, ";\n\n") + ";\n", nil
+}
+
+// DumpSchema writes the schema of a database to filename.
+func DumpSchema(conn *sql.DB, filename string) error {
+  schema, err := DialectOf(conn).Dump(conn)
+  if err != nil {
+    return err
+  }
+  return ioutil.WriteFile(filename, []byte(schema), 0666)
+}
+
+// LoadSchema drops the tables of a database and recreates it from the schema
+// in filename, in one transaction.
+func LoadSchema(conn *sql.DB, filename string) error {
+  schema, err := ioutil.ReadFile(filename)
+  if err != nil {
+    return err
+  }
+  tx, err := conn.Begin()
+  if err != nil {
+    return err
+  }
+  if err := DialectOf(conn).load(tx, string(schema)); err != nil {
+    tx.Rollback()
+    return err
+  }
+  return tx.Commit()
+}
+
+func (d Dialect) load(q Querier, schema string) error {
+  tables, err := d.Tables(q)
+  if err != nil {
+    return err
+  }
+  if d == SQLite {
+    // Foreign keys can't be turned off inside a transaction, so drop the
+    // tables that reference others first.
+    tables, err = d.dropOrder(q, tables)
+    if err != nil {
+      return err
+    }
+  }
+  for _, t := range tables {
+    drop := "DROP TABLE " + d.Quote(t)
+    if d == Postgres {
+      drop += " CASCADE"
+    }
+    if _, err := q.Exec(drop); err != nil {
+      return err
+    }
+  }
+  for _, s := range Statements(schema) {
+    if _, err := q.Exec(s); err != nil {
+      return fmt.Errorf("db: %v\n%v", err, s)
+    }
+  }
+  return nil
+}
+
+func (d Dialect) createOrder(q Querier, schemas []*Schema) ([]*Schema, error) {
+  tables := make([]*Table, len(schemas))
+  byName := make(map[string]*Schema)
+  for i, s := range schemas {
+    tables[i] = &Table{Name: s.Name, File: s.Name}
+    byName[s.Name] = s
+  }
+  ordered, err := Order(q, d, tables)
+  if err != nil {
+    return nil, err
+  }
+  for i, t := range ordered {
+    schemas[i] = byName[t.Name]
+  }
+  return schemas, nil
+}
+
+func (d Dialect) dropOrder(q Querier, names []string) ([]string, error) {
+  tables := make([]*Table, len(names))
+  for i, n := range names {
+    tables[i] = &Table{Name: n, File: n}
+  }
+  ordered, err := Order(q, d, tables)
+  if err != nil {
+    return nil, err
+  }
+  drop := make([]string, len(ordered))
+  for i, t := range ordered {
+    drop[len(ordered) - 1 - i] = t.Name
+  }
+  return drop, nil
+}
+
+// Statements splits SQL into statements on the semicolons that end them,
+// leaving out comments and the ones in strings and quoted names.
+func Statements(sql string) []string {
+  statements := make([]string, 0)
+  var b strings.Builder
+  flush := func() {
+    if s := strings.TrimSpace(b.String()); s != "" {
+      statements = append(statements, s)
+    }
+    b.Reset()
+  }
+  for i := 0; i < len(sql); i++ {
+    c := sql[i]
+    switch {
+    case c == '-' && i + 1 < len(sql) && sql[i + 1] == '-':
+      for i < len(sql) && sql[i] != '\n' {
+        i++
+      }
+      b.WriteByte('\n')
+    case c == '\'' || c == '"':
+      end := len(sql)
+      if j := strings.IndexByte(sql[i + 1:], c); j >= 0 {
+        end = i + j + 2
+      }
+      b.WriteString(sql[i:end])
+      i = end - 1
+    case c == ';':
+      flush()
+    default:
+      b.WriteByte(c)
+    }
+  }
+  flush()
+  return statements
+}
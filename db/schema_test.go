@@ -0,0 +1,94 @@
+package db
+
+import (
+  "io/ioutil"
+  "path/filepath"
+  "reflect"
+  "strings"
+  "testing"
+)
+
+func TestReadSchema(t *testing.T) {
+  conn := openSchema(t)
+  if _, err := conn.Exec("CREATE UNIQUE INDEX idx_users_name ON users (name)"); err != nil {
+    t.Fatal(err)
+  }
+  schemas, err := SQLite.ReadSchema(conn)
+  if err != nil {
+    t.Fatal(err)
+  }
+  names := make([]string, len(schemas))
+  for i, s := range schemas {
+    names[i] = s.Name
+  }
+  if want := []string{"comments", "posts", "users"}; !reflect.DeepEqual(names, want) {
+    t.Fatalf("tables = %v, want %v", names, want)
+  }
+  posts := schemas[1]
+  if id := posts.Column("id"); id == nil || !id.PrimaryKey || id.Type != "INTEGER" {
+    t.Errorf("posts.id = %+v, want an INTEGER primary key", id)
+  }
+  if c := posts.Column("user_id"); c == nil || c.References != "users.id" {
+    t.Errorf("posts.user_id = %+v, want a reference to users.id", c)
+  }
+  users := schemas[2]
+  if len(users.Indexes) != 1 || !users.Indexes[0].Unique || !users.HasIndex([]string{"name"}) {
+    t.Errorf("users indexes = %+v, want the unique index on name", users.Indexes)
+  }
+}
+
+func TestDumpAndLoadSchema(t *testing.T) {
+  conn := openSchema(t)
+  if _, err := conn.Exec("CREATE INDEX idx_posts_title ON posts (title)"); err != nil {
+    t.Fatal(err)
+  }
+  filename := filepath.Join(t.TempDir(), "schema.sql")
+  if err := DumpSchema(conn, filename); err != nil {
+    t.Fatal(err)
+  }
+  dump, _ := ioutil.ReadFile(filename)
+  for _, want := range []string{
+    "CREATE TABLE comments (id INTEGER PRIMARY KEY,",
+    "CREATE TABLE users (id INTEGER PRIMARY KEY, name TEXT);",
+    "CREATE INDEX idx_posts_title ON posts (title);",
+  } {
+    if !strings.Contains(string(dump), want) {
+      t.Errorf("schema.sql doesn't contain %v:\n%s", want, dump)
+    }
+  }
+
+  // Loading replaces the tables, even ones that are referenced.
+  if _, err := conn.Exec("CREATE TABLE extra (id INTEGER)"); err != nil {
+    t.Fatal(err)
+  }
+  if _, err := conn.Exec("INSERT INTO users (name) VALUES ('Ann')"); err != nil {
+    t.Fatal(err)
+  }
+  if err := LoadSchema(conn, filename); err != nil {
+    t.Fatal(err)
+  }
+  tables, _ := SQLite.Tables(conn)
+  if want := []string{"comments", "posts", "users"}; !reflect.DeepEqual(tables, want) {
+    t.Errorf("tables = %v, want %v", tables, want)
+  }
+  var n int
+  conn.QueryRow("SELECT count(*) FROM users").Scan(&n)
+  if n != 0 {
+    t.Errorf("users has %v rows after loading, want none", n)
+  }
+  again, _ := SQLite.Dump(conn)
+  if again != string(dump) {
+    t.Errorf("dump after loading = %s\nwant %s", again, dump)
+  }
+}
+
+func TestStatements(t *testing.T) {
+  got := Statements("-- a comment; with a semicolon\nCREATE TABLE a (x TEXT DEFAULT ';');\n\nINSERT INTO \"b;c\" VALUES ('it''s');\n")
+  want := []string{
+    "CREATE TABLE a (x TEXT DEFAULT ';')",
+    "INSERT INTO \"b;c\" VALUES ('it''s')",
+  }
+  if !reflect.DeepEqual(got, want) {
+    t.Errorf("Statements = %q, want %q", got, want)
+  }
+}
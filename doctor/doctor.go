@@ -0,0 +1,284 @@
+// Package doctor provides health checks for the eg environment and the ego
+// app in the working directory. Each check reports pass, warn or fail along
+// with a suggested fix.
+package doctor
+
+import (
+  "fmt"
+  "io/ioutil"
+  "net"
+  "os"
+  "os/exec"
+  "path/filepath"
+  "regexp"
+  "strconv"
+  "strings"
+  "github.com/murz/eg/config"
+  "github.com/murz/eg/dotenv"
+  "github.com/murz/eg/inspector"
+  "github.com/murz/eg/proxy"
+  "github.com/murz/eg/templates"
+)
+
+type Status string
+
+const (
+  Pass Status = "pass"
+  Warn Status = "warn"
+  Fail Status = "fail"
+)
+
+type Result struct {
+  Name string `json:"name"`
+  Status Status `json:"status"`
+  Message string `json:"message"`
+  Fix string `json:"fix,omitempty"`
+}
+
+// Check runs a single health check.
+type Check func() []Result
+
+// GenDir is the directory the dev build writes its generated files to.
+const GenDir = ".ego-genfiles"
+
+// Checks are run in order by Run.
+var Checks = []Check{
+  checkGo,
+  checkModule,
+  checkConfig,
+  checkEnvFiles,
+  checkLayout,
+  checkPorts,
+  checkGenDir,
+  checkTemplates,
+  checkStale,
+}
+
+// Run runs every check and returns the results in order.
+func Run() []Result {
+  results := make([]Result, 0)
+  for _, check := range Checks {
+    results = append(results, check()...)
+  }
+  return results
+}
+
+// OK reports whether none of the results failed.
+func OK(results []Result) bool {
+  for _, r := range results {
+    if r.Status == Fail {
+      return false
+    }
+  }
+  return true
+}
+
+var versionRegexp = regexp.MustCompile(`go([0-9]+)\.([0-9]+)`)
+var goDirectiveRegexp = regexp.MustCompile(`(?m)^go\s+([0-9]+)\.([0-9]+)`)
+
+// version returns the major and minor version in a match of versionRegexp or
+// goDirectiveRegexp.
+func version(m []string) (int, int) {
+  major, _ := strconv.Atoi(m[1])
+  minor, _ := strconv.Atoi(m[2])
+  return major, minor
+}
+
+func checkGo() []Result {
+  name := "go toolchain"
+  bin, err := exec.LookPath("go")
+  if err != nil {
+    return []Result{{name, Fail, "`go` was not found on PATH", "Install Go from https://go.dev/dl/ and add its bin directory to PATH"}}
+  }
+  out, err := exec.Command(bin, "env", "GOVERSION").Output()
+  if err != nil {
+    return []Result{{name, Fail, fmt.Sprintf("`go env` failed: %v", err), "Check your Go installation with `go env`"}}
+  }
+  goversion := strings.TrimSpace(string(out))
+  m := versionRegexp.FindStringSubmatch(goversion)
+  if m == nil {
+    return []Result{{name, Warn, fmt.Sprintf("couldn't parse the version of %v (%v)", bin, goversion), ""}}
+  }
+  txt, err := ioutil.ReadFile("go.mod")
+  if err == nil {
+    if want := goDirectiveRegexp.FindStringSubmatch(string(txt)); want != nil {
+      major, minor := version(m)
+      wantMajor, wantMinor := version(want)
+      if major < wantMajor || (major == wantMajor && minor < wantMinor) {
+        return []Result{{name, Fail, fmt.Sprintf("%v is older than the go %v.%v required by go.mod", goversion, wantMajor, wantMinor), "Upgrade Go from https://go.dev/dl/"}}
+      }
+    }
+  }
+  return []Result{{name, Pass, fmt.Sprintf("%v (%v)", goversion, bin), ""}}
+}
+
+func checkModule() []Result {
+  name := "go module"
+  if _, err := os.Stat("go.mod"); err != nil {
+    return []Result{{name, Warn, "no go.mod, the app will only build under GOPATH", "Run `go mod init <module path>` in the app directory"}}
+  }
+  module, err := inspector.ReadModule(".")
+  if err != nil {
+    return []Result{{name, Fail, err.Error(), "Add a `module <path>` line to go.mod"}}
+  }
+  results := []Result{{name, Pass, fmt.Sprintf("module %v", module), ""}}
+  txt, _ := ioutil.ReadFile("go.mod")
+  if !strings.Contains(string(txt), "github.com/murz/ego") {
+    results = append(results, Result{"ego dependency", Warn, "go.mod doesn't require github.com/murz/ego", "Run `go get github.com/murz/ego`"})
+  }
+  return results
+}
+
+func checkConfig() []Result {
+  name := "config"
+  f, err := config.Open()
+  if err != nil {
+    return []Result{{name, Fail, err.Error(), "Fix the syntax of the config file, or check it with `eg config list`"}}
+  }
+  if _, err := os.Stat(f.Path); err != nil {
+    return []Result{{name, Warn, fmt.Sprintf("no %v or %v, using the defaults", config.JSONFile, config.TOMLFile), "Create one with `eg config set name <app name>`"}}
+  }
+  if _, err := config.Load(); err != nil {
+    return []Result{{name, Fail, err.Error(), fmt.Sprintf("Check the values in %v and EG_* environment variables", f.Path)}}
+  }
+  return []Result{{name, Pass, fmt.Sprintf("%v (%v environment)", f.Path, config.Env()), ""}}
+}
+
+// checkEnvFiles parses the .env files `eg run` loads into the app's
+// environment.
+func checkEnvFiles() []Result {
+  name := ".env files"
+  files := append(dotenv.Files(config.Env()), config.Current().EnvFiles...)
+  vars, err := dotenv.Load(files)
+  if err != nil {
+    return []Result{{name, Fail, err.Error(), "Fix the line, each one is KEY=VALUE"}}
+  }
+  found := make([]string, 0)
+  for _, f := range files {
+    if _, err := os.Stat(f); err == nil {
+      found = append(found, f)
+    }
+  }
+  if len(found) == 0 {
+    return []Result{{name, Pass, "none", ""}}
+  }
+  return []Result{{name, Pass, fmt.Sprintf("%v keys from %v", len(vars), strings.Join(found, ", ")), ""}}
+}
+
+// layout lists the paths the generated server needs, and the ones the
+// bundled packs create but an app can live without.
+var layout = []struct {
+  Path string
+  Required bool
+}{
+  {"app", true},
+  {"app/controllers", true},
+  {"conf", true},
+  {"conf/routes.go", true},
+  {"conf/db.go", true},
+  {"app/models", false},
+  {"app/views", false},
+  {"public", false},
+}
+
+func checkLayout() []Result {
+  missing := make([]string, 0)
+  missingRequired := false
+  for _, l := range layout {
+    if _, err := os.Stat(l.Path); err != nil {
+      missing = append(missing, l.Path)
+      missingRequired = missingRequired || l.Required
+    }
+  }
+  name := "project layout"
+  switch {
+  case missingRequired:
+    return []Result{{name, Fail, fmt.Sprintf("missing %v", strings.Join(missing, ", ")), "Run eg from the root of an ego app, or create one with `eg new app <name>`"}}
+  case len(missing) > 0:
+    return []Result{{name, Warn, fmt.Sprintf("missing %v", strings.Join(missing, ", ")), "Create the missing directories if the app needs them"}}
+  }
+  return []Result{{name, Pass, "all expected directories and files exist", ""}}
+}
+
+func checkPorts() []Result {
+  results := make([]Result, 0)
+  for _, port := range []int{config.Current().Port, config.Current().ProxyPort} {
+    name := fmt.Sprintf("port %v", port)
+    ln, err := net.Listen("tcp", fmt.Sprintf(":%v", port))
+    if err != nil {
+      results = append(results, Result{name, Warn, fmt.Sprintf("in use (%v)", err), fmt.Sprintf("Stop whatever is listening on port %v, such as another `eg run`", port)})
+      continue
+    }
+    ln.Close()
+    results = append(results, Result{name, Pass, "available", ""})
+  }
+  return results
+}
+
+func checkGenDir() []Result {
+  name := GenDir
+  // Don't create GenDir just to look at it; its parent has to be writable for
+  // the dev build to create it.
+  dir := GenDir
+  if _, err := os.Stat(GenDir); err != nil {
+    dir = "."
+  }
+  f, err := ioutil.TempFile(dir, "doctor")
+  if err != nil {
+    return []Result{{name, Fail, err.Error(), fmt.Sprintf("Make sure you can write to %v", dir)}}
+  }
+  f.Close()
+  os.Remove(f.Name())
+  return []Result{{name, Pass, "writable", ""}}
+}
+
+func checkTemplates() []Result {
+  known := make(map[string]bool)
+  for _, name := range templates.Names() {
+    known[name] = true
+  }
+  results := make([]Result, 0)
+  for _, dir := range templates.Dirs() {
+    dirlist, err := ioutil.ReadDir(dir)
+    if err != nil {
+      continue
+    }
+    used := make([]string, 0)
+    for _, f := range dirlist {
+      if f.IsDir() {
+        continue
+      }
+      if !known[f.Name()] {
+        results = append(results, Result{"template overrides", Warn, fmt.Sprintf("%v is not an eg template and will be ignored", filepath.Join(dir, f.Name())), "Check the name against `eg templates list`"})
+        continue
+      }
+      used = append(used, f.Name())
+    }
+    if len(used) > 0 {
+      results = append(results, Result{"template overrides", Pass, fmt.Sprintf("%v overrides %v", dir, strings.Join(used, ", ")), ""})
+    }
+  }
+  if len(results) == 0 {
+    results = append(results, Result{"template overrides", Pass, "none, using the defaults", ""})
+  }
+  return results
+}
+
+// checkStale compares the app with the hashes the last build kept in
+// build.json.
+func checkStale() []Result {
+  name := "generated files"
+  if _, err := os.Stat(filepath.Join(GenDir, "build.json")); err != nil {
+    return []Result{{name, Pass, "nothing built yet", ""}}
+  }
+  generate, compile, err := proxy.Stale()
+  switch {
+  case err != nil:
+    return []Result{{name, Warn, fmt.Sprintf("couldn't inspect the app: %v", err), "Run `eg build` to see the error"}}
+  case generate:
+    return []Result{{name, Warn, "the app changed since the generated files were written", "Run `eg build` or `eg run` to regenerate them"}}
+  case compile:
+    return []Result{{name, Warn, "the app's sources changed since the last build", "Run `eg build` or `eg run` to rebuild it"}}
+  }
+  return []Result{{name, Pass, "up to date", ""}}
+}
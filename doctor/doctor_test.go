@@ -0,0 +1,163 @@
+package doctor
+
+import (
+  "io/ioutil"
+  "net"
+  "os"
+  "path/filepath"
+  "runtime"
+  "strings"
+  "testing"
+  "github.com/murz/eg/config"
+  "github.com/murz/eg/proxy"
+)
+
+// inApp changes into a temporary app made of files until the end of the test.
+func inApp(t *testing.T, files map[string]string) {
+  dir := t.TempDir()
+  for name, content := range files {
+    filename := filepath.Join(dir, name)
+    os.MkdirAll(filepath.Dir(filename), 0777)
+    if err := ioutil.WriteFile(filename, []byte(content), 0666); err != nil {
+      t.Fatal(err)
+    }
+  }
+  wd, _ := os.Getwd()
+  if err := os.Chdir(dir); err != nil {
+    t.Fatal(err)
+  }
+  t.Cleanup(func() {
+    os.Chdir(wd)
+    config.SetEnv("")
+  })
+}
+
+// statuses returns the statuses of results, like "pass,warn".
+func statuses(results []Result) string {
+  list := make([]string, 0)
+  for _, r := range results {
+    list = append(list, string(r.Status))
+  }
+  return strings.Join(list, ",")
+}
+
+// fakeGo puts a go on PATH that answers `go env GOVERSION` with version, or no
+// go at all for "".
+func fakeGo(t *testing.T, version string) {
+  bin := t.TempDir()
+  if version != "" {
+    ioutil.WriteFile(filepath.Join(bin, "go"), []byte("#!/bin/sh\necho " + version + "\n"), 0777)
+  }
+  t.Setenv("PATH", bin)
+}
+
+func TestCheckGo(t *testing.T) {
+  if runtime.GOOS == "windows" {
+    t.Skip("the fake go is a shell script")
+  }
+  tests := []struct {
+    version string
+    gomod string
+    want Status
+  }{
+    {"go1.21.5", "module demo\n\ngo 1.21\n", Pass},
+    {"go1.22.0", "module demo\n\ngo 1.21\n", Pass},
+    {"go1.20.1", "module demo\n\ngo 1.21\n", Fail},
+    {"go1.20.1", "module demo\n", Pass},
+    {"devel", "module demo\n", Warn},
+    {"", "module demo\n", Fail},
+  }
+  for _, test := range tests {
+    inApp(t, map[string]string{"go.mod": test.gomod})
+    fakeGo(t, test.version)
+    if got := checkGo(); statuses(got) != string(test.want) {
+      t.Errorf("checkGo with %q and %q = %v, want %v", test.version, test.gomod, got, test.want)
+    }
+  }
+}
+
+func TestCheckModule(t *testing.T) {
+  tests := []struct {
+    gomod string
+    want string
+  }{
+    {"module demo\n\nrequire github.com/murz/ego v0.1.0\n", "pass"},
+    {"module demo\n", "pass,warn"},
+    {"go 1.21\n", "fail"},
+    {"", "warn"},
+  }
+  for _, test := range tests {
+    files := map[string]string{}
+    if test.gomod != "" {
+      files["go.mod"] = test.gomod
+    }
+    inApp(t, files)
+    if got := checkModule(); statuses(got) != test.want {
+      t.Errorf("checkModule with %q = %v, want %v", test.gomod, got, test.want)
+    }
+  }
+}
+
+func TestCheckEnvFiles(t *testing.T) {
+  tests := []struct {
+    files map[string]string
+    want Status
+  }{
+    {map[string]string{}, Pass},
+    {map[string]string{".env": "SECRET=shh\n", ".env.local": "SECRET=local\nDEBUG=1\n"}, Pass},
+    {map[string]string{".env.development": "SECRET\n"}, Fail},
+    {map[string]string{".env": "SECRET='shh\n"}, Fail},
+  }
+  for _, test := range tests {
+    inApp(t, test.files)
+    if got := checkEnvFiles(); statuses(got) != string(test.want) {
+      t.Errorf("checkEnvFiles with %v = %v, want %v", test.files, got, test.want)
+    }
+  }
+}
+
+func TestCheckPorts(t *testing.T) {
+  inApp(t, map[string]string{})
+  ln, err := net.Listen("tcp", ":0")
+  if err != nil {
+    t.Fatal(err)
+  }
+  defer ln.Close()
+  free, _ := net.Listen("tcp", ":0")
+  free.Close()
+  config.Current().Port = ln.Addr().(*net.TCPAddr).Port
+  config.Current().ProxyPort = free.Addr().(*net.TCPAddr).Port
+  if got := checkPorts(); statuses(got) != "warn,pass" {
+    t.Errorf("checkPorts = %v, want the app's port in use and the proxy's available", got)
+  }
+}
+
+// nopBuilder builds nothing, as checkStale only looks at build.json.
+type nopBuilder struct{}
+
+func (nopBuilder) Build(root string, dir string, bin string) error {
+  return nil
+}
+
+func TestCheckStale(t *testing.T) {
+  inApp(t, map[string]string{
+    "go.mod": "module example.com/demo\n",
+    "conf/routes.go": "package conf\n\nfunc Routes() {}\n",
+    "app/controllers/posts_controller.go": "package controllers\n\ntype PostsController struct{}\n\nfunc (c PostsController) Index() {}\n",
+  })
+  if got := checkStale(); statuses(got) != "pass" {
+    t.Errorf("checkStale before a build = %v, want pass", got)
+  }
+  p := proxy.NewProxy()
+  p.Builder = nopBuilder{}
+  if _, err := p.Build(); err != nil {
+    t.Fatal(err)
+  }
+  if got := checkStale(); statuses(got) != "pass" {
+    t.Errorf("checkStale after a build = %v, want pass", got)
+  }
+  ioutil.WriteFile("app/controllers/posts_controller.go", []byte("package controllers\n\ntype PostsController struct{}\n\nfunc (c PostsController) Index() {}\n\nfunc (c PostsController) Show() {}\n"), 0666)
+  if got := checkStale(); statuses(got) != "warn" {
+    t.Errorf("checkStale after a new action = %v, want warn", got)
+  }
+}
@@ -0,0 +1,175 @@
+// Package dotenv provides loading of .env files for the ego dev server. Files
+// hold KEY=VALUE lines, with optional `export` prefixes, quoting and ${VAR}
+// expansion.
+package dotenv
+
+import (
+  "bufio"
+  "fmt"
+  "io"
+  "os"
+  "path/filepath"
+  "strings"
+)
+
+type Var struct {
+  Key string
+  Value string
+  File string
+}
+
+// Files returns the standard .env files for an environment, in load order.
+// Later files override earlier ones.
+func Files(env string) []string {
+  return []string{
+    ".env",
+    ".env." + env,
+    ".env.local",
+  }
+}
+
+// IsFile reports whether filename is one of files.
+func IsFile(filename string, files []string) bool {
+  filename = filepath.Clean(filename)
+  for _, f := range files {
+    if filepath.Clean(f) == filename {
+      return true
+    }
+  }
+  return false
+}
+
+// Load reads files in order, skipping any that don't exist. Values may refer
+// to variables defined earlier (in any of the files) or in the environment.
+func Load(files []string) ([]Var, error) {
+  vars := make([]Var, 0)
+  index := make(map[string]int)
+  lookup := func(key string) string {
+    if i, ok := index[key]; ok {
+      return vars[i].Value
+    }
+    return os.Getenv(key)
+  }
+  for _, filename := range files {
+    f, err := os.Open(filename)
+    if os.IsNotExist(err) {
+      continue
+    }
+    if err != nil {
+      return nil, err
+    }
+    parsed, err := Parse(f, lookup)
+    f.Close()
+    if err != nil {
+      return nil, fmt.Errorf("%v: %v", filename, err)
+    }
+    for _, v := range parsed {
+      v.File = filename
+      if i, ok := index[v.Key]; ok {
+        vars[i] = v
+      } else {
+        index[v.Key] = len(vars)
+        vars = append(vars, v)
+      }
+    }
+  }
+  return vars, nil
+}
+
+// Parse reads KEY=VALUE lines from r. Single-quoted values are taken
+// literally; double-quoted values understand \n, \" and \\; unquoted values
+// end at a " #" comment. Double-quoted and unquoted values have $VAR and
+// ${VAR} expanded, first against the lines already parsed and then lookup.
+func Parse(r io.Reader, lookup func(key string) string) ([]Var, error) {
+  vars := make([]Var, 0)
+  seen := make(map[string]string)
+  expand := func(s string) string {
+    return os.Expand(s, func(key string) string {
+      if v, ok := seen[key]; ok {
+        return v
+      }
+      return lookup(key)
+    })
+  }
+  scanner := bufio.NewScanner(r)
+  num := 0
+  for scanner.Scan() {
+    num++
+    line := strings.TrimSpace(scanner.Text())
+    if line == "" || strings.HasPrefix(line, "#") {
+      continue
+    }
+    line = strings.TrimPrefix(line, "export ")
+    eq := strings.Index(line, "=")
+    if eq <= 0 {
+      return nil, fmt.Errorf("line %v: expected KEY=VALUE", num)
+    }
+    key := strings.TrimSpace(line[0:eq])
+    raw := strings.TrimSpace(line[eq + 1:])
+    var value string
+    switch {
+    case strings.HasPrefix(raw, "'"):
+      end := strings.Index(raw[1:], "'")
+      if end < 0 {
+        return nil, fmt.Errorf("line %v: unterminated quote", num)
+      }
+      value = raw[1:end + 1]
+    case strings.HasPrefix(raw, `"`):
+      unquoted, ok := unquote(raw[1:])
+      if !ok {
+        return nil, fmt.Errorf("line %v: unterminated quote", num)
+      }
+      value = expand(unquoted)
+    default:
+      if i := strings.Index(raw, " #"); i >= 0 {
+        raw = strings.TrimSpace(raw[0:i])
+      }
+      value = expand(raw)
+    }
+    seen[key] = value
+    vars = append(vars, Var{Key: key, Value: value})
+  }
+  return vars, scanner.Err()
+}
+
+// unquote reads a double-quoted value up to its closing quote.
+func unquote(s string) (string, bool) {
+  var b strings.Builder
+  for i := 0; i < len(s); i++ {
+    switch c := s[i]; {
+    case c == '"':
+      return b.String(), true
+    case c == '\\' && i + 1 < len(s):
+      i++
+      switch s[i] {
+      case 'n':
+        b.WriteByte('\n')
+      case 't':
+        b.WriteByte('\t')
+      default:
+        b.WriteByte(s[i])
+      }
+    default:
+      b.WriteByte(c)
+    }
+  }
+  return "", false
+}
+
+// Environ returns vars as KEY=VALUE pairs for exec.Cmd.Env.
+func Environ(vars []Var) []string {
+  env := make([]string, len(vars))
+  for i, v := range vars {
+    env[i] = v.Key + "=" + v.Value
+  }
+  return env
+}
+
+// Mask hides a value for display, keeping the first couple of characters of
+// long values so they can be told apart.
+func Mask(value string) string {
+  if len(value) < 8 {
+    return "****"
+  }
+  return value[0:2] + "****"
+}
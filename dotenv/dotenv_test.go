@@ -0,0 +1,110 @@
+package dotenv
+
+import (
+  "io/ioutil"
+  "path/filepath"
+  "strings"
+  "testing"
+)
+
+func TestParse(t *testing.T) {
+  lookup := func(key string) string {
+    if key == "HOME" {
+      return "/home/me"
+    }
+    return ""
+  }
+  tests := []struct {
+    input string
+    want string
+  }{
+    {"A=1", "A=1"},
+    {"  A = 1  ", "A=1"},
+    {"export A=1", "A=1"},
+    {"# comment\n\nA=1", "A=1"},
+    {"A=1 # comment", "A=1"},
+    {"A=1#2", "A=1#2"},
+    {"A=", "A="},
+    {"A='$HOME # not a comment'", "A=$HOME # not a comment"},
+    {`A="a\nb\t\"c\"\\"`, "A=a\nb\t\"c\"\\"},
+    {`A="x" # comment`, "A=x"},
+    {"A=$HOME/bin", "A=/home/me/bin"},
+    {"A=${HOME}bin", "A=/home/mebin"},
+    {`A="${HOME} dir"`, "A=/home/me dir"},
+    {"A=x\nB=${A}y\nA=z", "A=x B=xy A=z"},
+    {"A=$MISSING", "A="},
+    {"A=b=c", "A=b=c"},
+  }
+  for _, test := range tests {
+    vars, err := Parse(strings.NewReader(test.input), lookup)
+    if err != nil {
+      t.Errorf("Parse(%q): %v", test.input, err)
+      continue
+    }
+    got := make([]string, len(vars))
+    for i, v := range vars {
+      got[i] = v.Key + "=" + v.Value
+    }
+    if strings.Join(got, " ") != test.want {
+      t.Errorf("Parse(%q) = %q, want %q", test.input, strings.Join(got, " "), test.want)
+    }
+  }
+
+  for _, bad := range []string{"A", "=1", "A='open", `A="open`, `A="escaped\"`} {
+    if _, err := Parse(strings.NewReader(bad), lookup); err == nil {
+      t.Errorf("Parse(%q) didn't fail", bad)
+    }
+  }
+}
+
+func TestLoad(t *testing.T) {
+  dir := t.TempDir()
+  files := map[string]string{
+    ".env": "HOST=localhost\nPORT=5432\nURL=postgres://${HOST}:${PORT}\n",
+    ".env.local": "PORT=6543\nLOCAL_URL=$URL/local\n",
+  }
+  for name, content := range files {
+    ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0666)
+  }
+  names := []string{filepath.Join(dir, ".env"), filepath.Join(dir, ".env.development"), filepath.Join(dir, ".env.local")}
+  vars, err := Load(names)
+  if err != nil {
+    t.Fatal(err)
+  }
+  want := []Var{
+    {"HOST", "localhost", names[0]},
+    {"PORT", "6543", names[2]},
+    {"URL", "postgres://localhost:5432", names[0]},
+    {"LOCAL_URL", "postgres://localhost:5432/local", names[2]},
+  }
+  if len(vars) != len(want) {
+    t.Fatalf("Load() = %v, want %v", vars, want)
+  }
+  for i, v := range vars {
+    if v != want[i] {
+      t.Errorf("var %v = %+v, want %+v", i, v, want[i])
+    }
+  }
+
+  ioutil.WriteFile(names[1], []byte("BROKEN\n"), 0666)
+  if _, err := Load(names); err == nil || !strings.Contains(err.Error(), ".env.development: line 1") {
+    t.Errorf("Load() = %v, want the file and line of the error", err)
+  }
+}
+
+func TestIsFileAndMask(t *testing.T) {
+  files := Files("test")
+  if !IsFile("./.env.test", files) || IsFile(".envrc", files) {
+    t.Errorf("IsFile doesn't match %v by clean path", files)
+  }
+  tests := map[string]string{
+    "": "****",
+    "secret": "****",
+    "longsecret": "lo****",
+  }
+  for value, want := range tests {
+    if got := Mask(value); got != want {
+      t.Errorf("Mask(%q) = %q, want %q", value, got, want)
+    }
+  }
+}
@@ -1,25 +1,61 @@
 package main
 
 import (
+	"crypto/tls"
+	"io"
 	"log"
 	"os"
-	"bytes"
-	"compress/gzip"
-	"io"
+	"os/exec"
+	"encoding/json"
+	"database/sql"
 	"flag"
+	"go/format"
 	"strings"
+	"time"
 	"github.com/hoisie/mustache"
 	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path/filepath"
 	"regexp"
+	"runtime"
+	"strconv"
 	"fmt"
+	"github.com/murz/eg/certs"
+	"github.com/murz/eg/config"
+	"github.com/murz/eg/console"
+	"github.com/murz/eg/db"
+	"github.com/murz/eg/doctor"
+	"github.com/murz/eg/faults"
+	"github.com/murz/eg/har"
+	"github.com/murz/eg/inspector"
+	"github.com/murz/eg/logger"
+	"github.com/murz/eg/packs"
 	"github.com/murz/eg/proxy"
+	"github.com/murz/eg/seeds"
 	"github.com/murz/eg/templates"
+	"github.com/murz/eg/testrunner"
+	"github.com/murz/eg/views"
+	// eg db connects to the app's databases itself.
+	_ "github.com/lib/pq"
+	_ "modernc.org/sqlite"
 )
 
+var egLog = logger.New("eg")
+
 func main() {
 	var path string;
 	flag.StringVar(&path, "path", "/", "")
 	args := os.Args[1:len(os.Args)] // throw out the first one because it's always eg
+	processFlags(args)
+	args = commandArgs(args)
+	setupLogging()
+	if flags["env"] != "" {
+		config.SetEnv(flags["env"])
+	}
+	if _, err := config.Load(); err != nil {
+		log.Printf("ego: %v", err)
+	}
 	if len(args) > 0 {
 		switch args[0] {
 		case "new", "n":
@@ -32,24 +68,178 @@ func main() {
 			run(args)
 		case "remove", "rm", "del", "delete":
 			delete(args)
+		case "templates", "tmpl":
+			templatesCmd(args)
+		case "doctor", "dr":
+			doctorCmd(args)
+		case "config", "conf":
+			configCmd(args)
+		case "test", "t":
+			test(args)
+		case "replay":
+			replay(args)
+		case "faults":
+			faultsCmd(args)
+		case "console", "c":
+			consoleCmd(args)
+		case "db":
+			dbCmd(args)
 		}
 	}
 }
 
+// setupLogging applies -v (debug output), -q (warnings and errors only) and
+// --log json, and sends the standard logger through eg's logger.
+func setupLogging() {
+	if flags["v"] == "true" {
+		logger.SetLevel(logger.Debug)
+	} else if flags["q"] == "true" {
+		logger.SetLevel(logger.Warn)
+	}
+	if flags["log"] == "json" {
+		logger.SetJSON(true)
+	}
+	log.SetFlags(0)
+	log.SetOutput(stdLog{egLog.Writer(logger.Info, ""), egLog.Writer(logger.Error, "")})
+}
+
+// stdLog logs what the log package writes as info, except for the messages
+// starting with "ego: ", which is how eg reports problems, so -q still shows
+// them.
+type stdLog struct {
+	info io.Writer
+	err io.Writer
+}
+
+func (w stdLog) Write(data []byte) (int, error) {
+	if strings.HasPrefix(string(data), "ego: ") {
+		return w.err.Write(data)
+	}
+	return w.info.Write(data)
+}
+
 func checkErr(err error) {
 	if err != nil {
-		log.Fatal(err)
+		egLog.Fatalf("%v", err)
 	}
 }
 
 func run(args []string) {
 	args = args[1:len(args)] // shave off the 'run' arg
 	processFlags(args)
+	if flags["env-file"] != "" {
+		cfg := config.Current()
+		cfg.EnvFiles = append(cfg.EnvFiles, strings.Split(flags["env-file"], ",")...)
+	}
+	if host, ok := flags["host"]; ok {
+		// The request inspector shows cookies and bodies, so exposing it is
+		// opt-in.
+		log.Printf("The dev proxy and its /_eg pages are reachable on %v", host)
+		proxy.Listen(host)
+	}
+	if flags["record"] != "" {
+		log.Printf("Recording requests to %v", flags["record"])
+		proxy.Record(flags["record"])
+	}
+	if flags["https"] == "true" {
+		hosts := config.Current().Hosts
+		if flags["hosts"] != "" {
+			hosts = append(hosts, strings.Split(flags["hosts"], ",")...)
+		}
+		cert, created, err := certs.Load(certs.Dir(), hosts)
+		checkErr(err)
+		if created {
+			log.Printf("Created a local certificate authority. Trust %v to avoid browser warnings.", filepath.Join(certs.Dir(), certs.CAFile))
+		}
+		proxy.ServeHTTPS(cert, flags["redirect"] == "true")
+	}
 	proxy.Run()
 
 }
 
 
+func test(args []string) {
+	flags["watch"] = "false"
+	processFlags(args[1:len(args)])
+	if flags["env"] == "" {
+		config.SetEnv("test")
+	}
+	// The dbtest helpers connect to the test database.
+	if cfg := config.Current().Database; cfg.Driver != "" {
+		os.Setenv("EGO_DB_DRIVER", cfg.Driver)
+		os.Setenv("EGO_DB_DSN", cfg.DSN)
+	}
+	if dbs := config.Current().AllDBs(); len(dbs) > 0 {
+		data, _ := json.Marshal(dbs)
+		os.Setenv(db.EnvVar, string(data))
+	}
+	goArgs := make([]string, 0)
+	if flags["run"] != "" {
+		goArgs = append(goArgs, "-run", flags["run"])
+	}
+
+	proxy.Generate()
+	pkgs, err := testrunner.List()
+	checkErr(err)
+	names := make([]string, 0)
+	for _, pkg := range pkgs {
+		names = append(names, pkg.ImportPath)
+	}
+	report, err := testrunner.Run(names, goArgs, os.Stdout)
+	if err != nil && (report == nil || report.Passed()) {
+		// Nothing in the report explains the failure, so show the error.
+		checkErr(err)
+	}
+	if flags["watch"] != "true" {
+		if err != nil || !report.Passed() {
+			os.Exit(1)
+		}
+		return
+	}
+
+	watcher, err := proxy.Watch("app", "conf")
+	checkErr(err)
+	log.Print("Watching for changes...")
+	for {
+		var changed []string
+		select {
+		case evt := <-watcher.Event:
+			changed = []string{evt.Name}
+		case err := <-watcher.Error:
+			log.Printf("ego: %v", err)
+			continue
+		}
+		// Editors write several events per save, so collect them before running.
+		timeout := time.After(300 * time.Millisecond)
+	collect:
+		for {
+			select {
+			case evt := <-watcher.Event:
+				changed = append(changed, evt.Name)
+			case err := <-watcher.Error:
+				log.Printf("ego: %v", err)
+			case <-timeout:
+				break collect
+			}
+		}
+
+		proxy.Generate()
+		pkgs, err := testrunner.List()
+		if err != nil {
+			log.Printf("ego: %v", err)
+			continue
+		}
+		affected := testrunner.Affected(pkgs, changed)
+		if len(affected) == 0 {
+			continue
+		}
+		log.Printf("Running tests for %v", strings.Join(affected, ", "))
+		if report, err := testrunner.Run(affected, goArgs, os.Stdout); err != nil && (report == nil || report.Passed()) {
+			log.Printf("ego: %v", err)
+		}
+	}
+}
+
 func new(args []string) {
 	if len(args) < 2 {
 		log.Print("ego: Not enough args for `eg new`. Use `eg help` for more info.")
@@ -63,6 +253,14 @@ func new(args []string) {
 		newController(args)
 	case "action", "actn":
 		newAction(args)
+	case "view", "v":
+		newView(args)
+	case "helper", "hlpr":
+		newHelper(args)
+	case "model", "m":
+		newModel(args)
+	case "error-page", "err":
+		newErrorPage(args)
 	}
 }
 
@@ -85,43 +283,45 @@ func newApp(args []string) {
 	}
 	args = args[1:len(args)] // shave off the 'app' arg
 	name := args[0]
-	os.Mkdir(name, 0777)
-	os.Mkdir(name+"/app", 0777)
-	os.Mkdir(name+"/app/controllers", 0777)
-	os.Mkdir(name+"/app/helpers", 0777)
-	os.Mkdir(name+"/app/models", 0777)
-	os.Mkdir(name+"/app/views", 0777)
-	os.Mkdir(name+"/app/views/errors", 0777)
-	os.Mkdir(name+"/app/assets", 0777)
-	os.Mkdir(name+"/app/assets/javascripts", 0777)
-	os.Mkdir(name+"/app/assets/stylesheets", 0777)
-	os.Mkdir(name+"/app/assets/images", 0777)
-	os.Mkdir(name+"/conf", 0777)
-	os.Mkdir(name+"/public", 0777)
-
-    routesconf := mustache.Render(string(templates.Routes()), map[string]string{})
-    routesconfFile, _ := os.Create(name+"/conf/routes.go")
-    routesconfFile.Write([]byte(routesconf))
-
-    dbconf := mustache.Render(string(templates.Databases()), map[string]string{})
-    dbconfFile, _ := os.Create(name+"/conf/db.go")
-    dbconfFile.Write([]byte(dbconf))
-
-	error404view := mustache.Render(string(error_html_mustache()), map[string]string {
-		"Message": "404 Not Found",
-	})
-	error404viewFile, _ := os.Create(name+"/app/views/errors/404.html")
-	error404viewFile.Write([]byte(error404view))
+	flags["template"] = "html"
+	flags["module"] = name
+	flags["go"] = goVersion()
+	flags["ego"] = "latest"
+	flags["eg"] = proxy.ModuleVersion()
+	processFlags(args[1:len(args)])
+
+	pack, err := packs.Load(flags["template"])
+	checkErr(err)
 
-	error501view := mustache.Render(string(error_html_mustache()), map[string]string {
-		"Message": "501 Not Implemented",
-	})
-	error501viewFile, _ := os.Create(name+"/app/views/errors/501.html")
-	error501viewFile.Write([]byte(error501view))
+	given := map[string]string{
+		"Name": name,
+		"Module": flags["module"],
+		"GoVersion": flags["go"],
+		"EgoVersion": flags["ego"],
+		"EgVersion": flags["eg"],
+	}
+	for _, v := range pack.Variables {
+		if val, ok := flags[v.Name]; ok {
+			given[v.Name] = val
+		}
+	}
+	vars := pack.Resolve(given, os.Stdin, os.Stdout)
+	checkErr(pack.Generate(name, vars))
 
 	log.Printf("Your new ego application, '%v', was successfully created", args[0])
 }
 
+var goVersionRegexp = regexp.MustCompile("go([0-9]+\\.[0-9]+)")
+
+// goVersion returns the language version written to the go.mod of new apps,
+// based on the toolchain eg was built with.
+func goVersion() string {
+	if m := goVersionRegexp.FindStringSubmatch(runtime.Version()); m != nil {
+		return m[1]
+	}
+	return "1.21"
+}
+
 var flags = map[string]string {
 	"file": "",
 	"method": "GET",
@@ -129,6 +329,18 @@ var flags = map[string]string {
 	"port": "5000",
 }
 
+// boolFlags are only ever on or off, so they never take the next argument as
+// their value: `eg -v run` is verbose and runs. --name=false still works.
+var boolFlags = map[string]bool {
+	"v": true,
+	"q": true,
+	"watch": true,
+	"https": true,
+	"redirect": true,
+	"check": true,
+	"json": true,
+}
+
 func newController(args []string) {
 	if len(args) < 2 {
 		log.Print("ego: Not enough args for `eg new controller`. Use `eg help` for more info.")
@@ -151,7 +363,7 @@ func newController(args []string) {
 	ctrlFile, err := os.Create("app/controllers/"+strings.ToLower(name)+"_controller.go")
 	checkErr(err)
 
-	ctrl := mustache.Render(string(templates.Controller()), map[string]string {
+	ctrl := mustache.Render(string(templates.Get("controller.go.mustache")), map[string]string {
 		"Name": strings.Title(name) + "Controller",
 		"Embed": "*http.Controller",
 	})
@@ -187,11 +399,11 @@ func newAction(args []string) {
 	} else {
 		actionFile, err = os.Create("app/actions/"+strings.ToLower(name)+".go")
 		checkErr(err)
-		cruft := mustache.Render(string(actionfile_go_mustache()), map[string]string {})
+		cruft := mustache.Render(string(templates.Get("actionfile.go.mustache")), map[string]string {})
 		actionFile.WriteString(cruft)
 	}
 
-	action := mustache.Render(string(action_go_mustache()), map[string]string {
+	action := mustache.Render(string(templates.Get("action.go.mustache")), map[string]string {
 		"Name": strings.Title(name),
 		"Path": flags["path"],
 		"Method": flags["method"],
@@ -203,6 +415,188 @@ func newAction(args []string) {
 	log.Printf("Action '%v', was successfully created", name)
 }
 
+// newView creates app/views/<name>.html. With --layout it also creates the
+// layout the view is rendered in, layouts/application or the one named.
+func newView(args []string) {
+	if len(args) < 2 {
+		log.Print("ego: Not enough args for `eg new view`. Use `eg help` for more info.")
+		return
+	}
+	if (!checkDirs([]string{
+		"app",
+		"app/views",
+	})) {
+		log.Print("ego: You must be in an ego project directory to use `eg new view`.")
+		return
+	}
+	name := strings.TrimSuffix(args[1], views.Ext)
+	args = args[2:len(args)] // shave off the 'view name' args
+	processFlags(args)
+
+	if strings.HasPrefix(name, "layouts/") {
+		createView(name, "layout.html.mustache")
+		return
+	}
+	createView(name, "view.html.mustache")
+	if l := flags["layout"]; l != "" {
+		if l == "true" {
+			l = "application"
+		}
+		createView("layouts/" + l, "layout.html.mustache")
+	}
+}
+
+// createView renders a view template to app/views/<name>.html, leaving views
+// that already exist alone.
+func createView(name string, tmpl string) {
+	filename := filepath.Join(views.Dir, filepath.FromSlash(name) + views.Ext)
+	if ex, _ := exists(filename); ex {
+		log.Printf("ego: %v already exists", filename)
+		return
+	}
+	checkErr(os.MkdirAll(filepath.Dir(filename), 0777))
+	wd, _ := os.Getwd()
+	view := mustache.Render(string(templates.Get(tmpl)), map[string]string {
+		"Name": filepath.Base(wd),
+		"Title": strings.Title(strings.Replace(filepath.Base(name), "_", " ", -1)),
+		"Path": filepath.ToSlash(filename),
+	})
+	checkErr(ioutil.WriteFile(filename, []byte(view), 0666))
+	log.Printf("View '%v', was successfully created", name)
+}
+
+// newHelper creates app/helpers/<name>.go with an exported function, which
+// the build registers in the views' function map.
+func newHelper(args []string) {
+	if len(args) < 2 {
+		log.Print("ego: Not enough args for `eg new helper`. Use `eg help` for more info.")
+		return
+	}
+	if (!checkDirs([]string{
+		"app",
+	})) {
+		log.Print("ego: You must be in an ego project directory to use `eg new helper`.")
+		return
+	}
+	name := args[1]
+	args = args[2:len(args)] // shave off the 'helper name' args
+	processFlags(args)
+
+	filename := filepath.Join("app/helpers", strings.ToLower(name) + ".go")
+	if ex, _ := exists(filename); ex {
+		log.Printf("ego: %v already exists", filename)
+		return
+	}
+	checkErr(os.MkdirAll("app/helpers", 0777))
+	helper := mustache.Render(string(templates.Get("helper.go.mustache")), map[string]string {
+		"Func": camelCase(name),
+	})
+	checkErr(ioutil.WriteFile(filename, []byte(helper), 0666))
+	log.Printf("Helper '%v', was successfully created", name)
+}
+
+// newErrorPage creates app/views/errors/<code>.html, which the app shows for
+// that status.
+func newErrorPage(args []string) {
+	if len(args) < 2 {
+		log.Print("ego: Not enough args for `eg new error-page`. Use `eg help` for more info.")
+		return
+	}
+	if (!checkDirs([]string{
+		"app",
+		"app/views",
+	})) {
+		log.Print("ego: You must be in an ego project directory to use `eg new error-page`.")
+		return
+	}
+	code := args[1]
+	args = args[2:len(args)] // shave off the 'code' args
+	processFlags(args)
+
+	status, err := strconv.Atoi(code)
+	if err != nil || http.StatusText(status) == "" {
+		log.Printf("ego: %v isn't an HTTP status code", code)
+		return
+	}
+	filename := filepath.Join(views.Dir, "errors", code + views.Ext)
+	if ex, _ := exists(filename); ex {
+		log.Printf("ego: %v already exists", filename)
+		return
+	}
+	checkErr(os.MkdirAll(filepath.Dir(filename), 0777))
+	page := mustache.Render(string(templates.Get("error.html.mustache")), map[string]string {
+		"Message": fmt.Sprintf("%v %v", status, http.StatusText(status)),
+	})
+	checkErr(ioutil.WriteFile(filename, []byte(page), 0666))
+	log.Printf("Error page '%v', was successfully created", code)
+}
+
+// newModel creates app/models/<name>.go with a struct of the given
+// name:type fields. With --db <name> the model declares the database it's
+// stored in.
+func newModel(args []string) {
+	if len(args) < 2 {
+		log.Print("ego: Not enough args for `eg new model`. Use `eg help` for more info.")
+		return
+	}
+	if (!checkDirs([]string{
+		"app",
+	})) {
+		log.Print("ego: You must be in an ego project directory to use `eg new model`.")
+		return
+	}
+	name := camelCase(args[1])
+	filename := filepath.Join("app/models", strings.ToLower(args[1]) + ".go")
+	args = args[2:len(args)] // shave off the 'model name' args
+
+	fields := make([]map[string]string, 0)
+	hasTime := false
+	for len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		parts := strings.SplitN(args[0], ":", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			egLog.Fatalf("ego: '%v' isn't a field, use name:type such as title:string", args[0])
+		}
+		fields = append(fields, map[string]string{"Name": camelCase(parts[0]), "Type": parts[1]})
+		hasTime = hasTime || strings.Contains(parts[1], "time.")
+		args = args[1:len(args)]
+	}
+	processFlags(args)
+	if flags["db"] != "" {
+		if _, err := config.Current().DB(flags["db"]); err != nil {
+			log.Printf("ego: Add the %v database to conf/app.json before using the model (%v)", flags["db"], err)
+		}
+	}
+
+	if ex, _ := exists(filename); ex {
+		log.Printf("ego: %v already exists", filename)
+		return
+	}
+	checkErr(os.MkdirAll("app/models", 0777))
+	model := mustache.Render(string(templates.Get("model.go.mustache")), map[string]interface{} {
+		"Name": name,
+		"Table": db.TableName(name),
+		"Fields": fields,
+		"HasTime": hasTime,
+		"HasDatabase": flags["db"] != "" && flags["db"] != config.Primary,
+		"Database": flags["db"],
+	})
+	code, err := format.Source([]byte(model))
+	checkErr(err)
+	checkErr(ioutil.WriteFile(filename, code, 0666))
+	log.Printf("Model '%v', was successfully created", name)
+}
+
+// camelCase turns a name like format_date into FormatDate.
+func camelCase(name string) string {
+	parts := strings.FieldsFunc(name, func(r rune) bool {
+		return r == '_' || r == '-'
+	})
+	for i, p := range parts {
+		parts[i] = strings.Title(p)
+	}
+	return strings.Join(parts, "")
+}
+
 func deleteAction(args []string) {
 	if len(args) < 2 {
 		log.Print("ego: Not enough args for `eg rm action`. Use `eg help` for more info.")
@@ -245,45 +639,535 @@ func deleteAction(args []string) {
 	actionFile.Seek(0, 0)
 
 	actionFile.WriteString(newStr)
+}
+
+func templatesCmd(args []string) {
+	if len(args) < 2 {
+		log.Print("ego: Not enough args for `eg templates`. Use `eg help` for more info.")
+		return
+	}
+	args = args[1:len(args)] // shave off the 'templates' arg
+	switch(args[0]) {
+	case "list", "ls":
+		listTemplates(args)
+	case "eject":
+		ejectTemplate(args)
+	case "generate", "gen":
+		generateTemplates(args)
+	}
+}
 
-	log.Printf("pos: %v", pos)
+func listTemplates(args []string) {
+	for _, name := range templates.Names() {
+		_, src, err := templates.Find(name)
+		if err != nil {
+			log.Printf("%v (error: %v)", name, err)
+		} else if src == "" {
+			log.Printf("%v (default)", name)
+		} else {
+			log.Printf("%v (%v)", name, src)
+		}
+	}
 }
 
+func ejectTemplate(args []string) {
+	if len(args) < 2 {
+		log.Print("ego: Not enough args for `eg templates eject`. Use `eg help` for more info.")
+		return
+	}
+	name := args[1]
+	if !strings.HasSuffix(name, ".mustache") {
+		name += ".mustache"
+	}
+	flags["dir"] = templates.LocalDir
+	processFlags(args[2:len(args)])
+
+	filename, err := templates.Eject(name, flags["dir"])
+	checkErr(err)
+	log.Printf("Template '%v' was ejected to %v", name, filename)
+}
+
+func generateTemplates(args []string) {
+	flags["src"] = "templates/mustache"
+	flags["out"] = "templates"
+	flags["check"] = "false"
+	processFlags(args[1:len(args)])
+
+	if flags["check"] == "true" {
+		stale, err := templates.Check(flags["src"], flags["out"])
+		checkErr(err)
+		if len(stale) > 0 {
+			egLog.Fatalf("ego: Embedded templates are out of date (%v). Run `go generate ./templates`.", strings.Join(stale, ", "))
+		}
+		log.Print("Embedded templates are up to date")
+		return
+	}
+	checkErr(templates.Generate(flags["src"], flags["out"]))
+	log.Printf("Embedded templates were generated from %v", flags["src"])
+}
+
+// commandArgs drops the flags before the command, like the -v of `eg -v run`.
+func commandArgs(args []string) []string {
+	for len(args) > 0 && len(args[0]) > 1 && args[0][0:1] == "-" {
+		name := strings.TrimLeft(args[0], "-")
+		if name == "" || strings.Contains(name, "=") || boolFlags[name] || len(args) == 1 {
+			args = args[1:]
+		} else {
+			args = args[2:]
+		}
+	}
+	return args
+}
+
+// processFlags reads -name value, -name=value and --name forms into flags. A
+// flag with no value (last, or followed by another flag) is set to "true".
 func processFlags(args []string) {
 	next := ""
 	for _, arg := range args {
-		if next != "" {
+		// A lone - is a value, like an empty argument.
+		isFlag := len(arg) > 1 && arg[0:1] == "-"
+		if next != "" && !isFlag {
 			flags[next] = arg
 			next = ""
-		} else if arg[0:1] == "-" {
+		} else if isFlag {
+			if next != "" {
+				flags[next] = "true"
+				next = ""
+			}
 			name := arg[1:]
 			if name[0:1] == "-" {
 				name = name[1:]
 			}
+			if name == "" {
+				continue
+			}
 			pieces := strings.Split(name, "=")
 			if len(pieces) > 1 {
 				flags[pieces[0]] = pieces[1]
+			} else if boolFlags[name] {
+				flags[name] = "true"
 			} else {
 				next = name
 			}
 		}
 	}
+	if next != "" {
+		flags[next] = "true"
+	}
 }
 
 func build(args []string) {
-	// package views
-	// dirlist, err := ioutil.ReadDir("/app/views")
-	// if err != nil {
-	// 	log.Fatalf("Error reading %s: %s\n", dirname, err)
-	// }
-	// for _, f := range dirlist {
-	// 	filename := path.Join(tm.pkgName, dirname, f.Name())
-	// 	if f.IsDir() {
-	// 		tm.ParseDir(path.Join(dirname, f.Name()))
-	// 	} else {
-	// 		tm.Parse(filename)
-	// 	}
-	// }
+	processFlags(args[1:len(args)])
+	bin, err := proxy.Build()
+	if err != nil {
+		egLog.Fatalf("ego: Build failed:\n%v", err)
+	}
+	log.Printf("Your ego application was built to %v", bin)
+}
+
+// consoleCmd builds the console for the app and runs it in the terminal, in the
+// environment `eg run` gives the app.
+func consoleCmd(args []string) {
+	args = args[1:len(args)] // shave off the 'console' arg
+	processFlags(args)
+	if (!checkDirs([]string{
+		"app",
+		"conf",
+	})) {
+		log.Print("ego: You must be in an ego project directory to use `eg console`.")
+		return
+	}
+	bin, err := console.Build(proxy.GoBuilder{})
+	if err != nil {
+		egLog.Fatalf("ego: Building the console failed:\n%v", err)
+	}
+	cmd := exec.Command(bin)
+	cmd.Env = proxy.Env()
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		if exit, ok := err.(*exec.ExitError); ok {
+			os.Exit(exit.ExitCode())
+		}
+		checkErr(err)
+	}
+}
+
+func dbCmd(args []string) {
+	if len(args) < 2 {
+		log.Print("ego: Not enough args for `eg db`. Use `eg help` for more info.")
+		return
+	}
+	args = args[1:len(args)] // shave off the 'db' arg
+	switch(args[0]) {
+	case "seed":
+		dbSeed(args)
+	case "schema:dump":
+		dbSchemaDump(args)
+	case "schema:load":
+		dbSchemaLoad(args)
+	case "diff":
+		dbDiff(args)
+	}
+}
+
+// dbName is the database `eg db` works on, chosen with --db.
+func dbName() string {
+	if flags["db"] == "" {
+		return config.Primary
+	}
+	return flags["db"]
+}
+
+// dbConfig returns the config of the database chosen with --db.
+func dbConfig() config.Database {
+	cfg, err := config.Current().DB(flags["db"])
+	checkErr(err)
+	return cfg
+}
+
+// openDB connects to the database chosen with --db, the primary one by
+// default.
+func openDB() *sql.DB {
+	if (!checkDirs([]string{
+		"app",
+		"conf",
+	})) {
+		egLog.Fatalf("ego: You must be in an ego project directory to use `eg db`.")
+	}
+	cfg := dbConfig()
+	conn, err := db.Open(cfg.Driver, cfg.DSN)
+	checkErr(err)
+	return conn
+}
+
+// dbSchemaDump writes the schema of the database to db/schema.sql, or
+// db/<name>/schema.sql for the other databases.
+func dbSchemaDump(args []string) {
+	processFlags(args[1:len(args)])
+	conn := openDB()
+	defer conn.Close()
+	filename := db.Path(dbName(), db.SchemaFile)
+	checkErr(os.MkdirAll(filepath.Dir(filename), 0777))
+	checkErr(db.DumpSchema(conn, filename))
+	log.Printf("Dumped the schema of the %v database to %v", dbName(), filename)
+}
+
+// dbSchemaLoad drops the tables of the database and recreates them from its
+// schema.sql.
+func dbSchemaLoad(args []string) {
+	processFlags(args[1:len(args)])
+	filename := db.Path(dbName(), db.SchemaFile)
+	if ex, _ := exists(filename); !ex {
+		egLog.Fatalf("ego: There's no %v, use `eg db schema:dump` to write one", filename)
+	}
+	conn := openDB()
+	defer conn.Close()
+	checkErr(db.LoadSchema(conn, filename))
+	log.Printf("Loaded the schema of the %v database from %v", dbName(), filename)
+}
+
+// dbDiff compares the structs of app/models stored in the database with its
+// tables and writes a migration for the differences to db/migrations, or
+// db/<name>/migrations for the other databases.
+func dbDiff(args []string) {
+	name := "update_schema"
+	if len(args) > 1 && !strings.HasPrefix(args[1], "-") {
+		name = args[1]
+		args = args[1:len(args)]
+	}
+	processFlags(args[1:len(args)])
+	conn := openDB()
+	defer conn.Close()
+	d := db.DialectOf(conn)
+
+	inspector.InitActions()
+	inspector.Inspect()
+	models := make([]*db.Schema, 0)
+	for _, m := range inspector.GetModels() {
+		if m.Kind != "type" || m.Fields == nil {
+			continue
+		}
+		if m.Database != dbName() && (m.Database != "" || dbName() != config.Primary) {
+			continue
+		}
+		model := db.Model{Name: m.Name}
+		for _, f := range m.Fields {
+			model.Fields = append(model.Fields, db.Field{Name: f.Key, Type: f.Value, Tag: f.Tag})
+		}
+		if s := d.Schema(model); s != nil {
+			models = append(models, s)
+		}
+	}
+	live, err := d.ReadSchema(conn)
+	checkErr(err)
+
+	statements, notes := d.Diff(models, live)
+	for _, n := range notes {
+		egLog.Warnf("%v", n)
+	}
+	if len(statements) == 0 {
+		log.Print("The database matches the models")
+		return
+	}
+	filename, err := db.WriteMigration(db.Path(dbName(), db.MigrationsDir), name, statements, notes)
+	checkErr(err)
+	log.Printf("Migration '%v', was successfully created", filename)
+}
+
+// dbSeed loads db/seeds/*.yml in dependency order and then runs the Seed
+// function of db/seeds.go, if the app has them. The other databases are
+// seeded from db/<name>/seeds/*.yml.
+func dbSeed(args []string) {
+	processFlags(args[1:len(args)])
+	driver, err := db.Lookup(dbConfig().Driver)
+	checkErr(err)
+	conn := openDB()
+	defer conn.Close()
+
+	seedsDir := db.Path(dbName(), db.SeedsDir)
+	tables, err := db.Seed(conn, seedsDir)
+	checkErr(err)
+	for _, t := range tables {
+		log.Printf("Seeded %v with %v rows from %v", t.Name, len(t.Rows), t.File)
+	}
+
+	if dbName() != config.Primary {
+		if len(tables) == 0 {
+			egLog.Warnf("Nothing to seed, add %v/*.yml", seedsDir)
+		}
+		return
+	}
+	if ex, _ := exists(seeds.File); !ex {
+		if len(tables) == 0 {
+			egLog.Warnf("Nothing to seed, add %v or %v/*.yml", seeds.File, db.SeedsDir)
+		}
+		return
+	}
+	bin, err := seeds.Build(proxy.GoBuilder{}, driver)
+	if err != nil {
+		egLog.Fatalf("ego: Building %v failed:\n%v", seeds.File, err)
+	}
+	cmd := exec.Command(bin)
+	cmd.Env = proxy.Env()
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		egLog.Fatalf("ego: %v failed: %v", seeds.File, err)
+	}
+	log.Printf("Seeded the database with %v", seeds.File)
+}
+
+func configCmd(args []string) {
+	if len(args) < 2 {
+		log.Print("ego: Not enough args for `eg config`. Use `eg help` for more info.")
+		return
+	}
+	args = args[1:len(args)] // shave off the 'config' arg
+	switch(args[0]) {
+	case "get":
+		configGet(args)
+	case "set":
+		configSet(args)
+	case "list", "ls":
+		configList(args)
+	}
+}
+
+func configGet(args []string) {
+	if len(args) < 2 {
+		log.Print("ego: Not enough args for `eg config get`. Use `eg help` for more info.")
+		return
+	}
+	settings, err := config.Effective()
+	checkErr(err)
+	val, ok := settings[args[1]]
+	if !ok {
+		egLog.Fatalf("ego: '%v' is not set", args[1])
+	}
+	fmt.Println(configValue(val))
+}
+
+func configSet(args []string) {
+	if len(args) < 3 {
+		log.Print("ego: Not enough args for `eg config set`. Use `eg help` for more info.")
+		return
+	}
+	f, err := config.Open()
+	checkErr(err)
+	key := args[1]
+	if flags["env"] != "" {
+		key = flags["env"] + "." + key
+	}
+	f.Set(key, args[2])
+	checkErr(f.Save())
+	log.Printf("Set '%v' in %v", key, f.Path)
+}
+
+func configList(args []string) {
+	settings, err := config.Effective()
+	checkErr(err)
+	fmt.Printf("# %v environment\n", config.Env())
+	for _, key := range config.Keys(settings) {
+		fmt.Printf("%v = %v\n", key, configValue(settings[key]))
+	}
+}
+
+// configValue formats a config value the way it would be written in
+// conf/app.json.
+func configValue(val interface{}) string {
+	if str, ok := val.(string); ok {
+		return str
+	}
+	out, _ := json.Marshal(val)
+	return string(out)
+}
+
+// faultsClient is the client `eg faults` talks to the dev proxy with. Under
+// `eg run --https --redirect` the proxy's HTTP port redirects to HTTPS, so the
+// client follows it and trusts the certificate authority eg serves with.
+func faultsClient() *http.Client {
+	pool, err := certs.Pool(certs.Dir())
+	if err != nil {
+		return http.DefaultClient
+	}
+	return &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool}}}
+}
+
+// faultsCmd changes the faults injected by a running `eg run`.
+func faultsCmd(args []string) {
+	if len(args) < 2 {
+		log.Print("ego: Not enough args for `eg faults`. Use `eg help` for more info.")
+		return
+	}
+	args = args[1:len(args)] // shave off the 'faults' arg
+	api := fmt.Sprintf("http://localhost:%v/_eg/faults.json", config.Current().ProxyPort)
+	client := faultsClient()
+	var resp *http.Response
+	var err error
+	switch(args[0]) {
+	case "set":
+		// Rules apply to every method and path unless they're given.
+		flags["method"] = ""
+		flags["path"] = "/**"
+		processFlags(args)
+		values := url.Values{}
+		for _, key := range []string{"path", "method", "latency", "jitter", "errors", "status", "drop", "bandwidth"} {
+			if flags[key] != "" {
+				values.Set(key, flags[key])
+			}
+		}
+		resp, err = client.PostForm(api, values)
+	case "clear":
+		req, _ := http.NewRequest("DELETE", api, nil)
+		resp, err = client.Do(req)
+	case "list", "ls":
+		resp, err = client.Get(api)
+	default:
+		log.Printf("ego: Unknown `eg faults` command '%v'. Use `eg help` for more info.", args[0])
+		return
+	}
+	if err != nil {
+		egLog.Fatalf("ego: Couldn't reach the dev proxy, is `eg run` running? (%v)", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := ioutil.ReadAll(resp.Body)
+		egLog.Fatalf("ego: %v", strings.TrimSpace(string(msg)))
+	}
+	rules := make([]*faults.Rule, 0)
+	checkErr(json.NewDecoder(resp.Body).Decode(&rules))
+	if len(rules) == 0 {
+		fmt.Println("No faults are being injected.")
+	}
+	for _, r := range rules {
+		fmt.Println(r)
+	}
+}
+
+func doctorCmd(args []string) {
+	flags["json"] = "false"
+	processFlags(args[1:len(args)])
+
+	results := doctor.Run()
+	if flags["json"] == "true" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "  ")
+		checkErr(enc.Encode(map[string]interface{} {
+			"ok": doctor.OK(results),
+			"checks": results,
+		}))
+	} else {
+		for _, r := range results {
+			fmt.Printf("[%v] %v: %v\n", r.Status, r.Name, r.Message)
+			if r.Status != doctor.Pass && r.Fix != "" {
+				fmt.Printf("       fix: %v\n", r.Fix)
+			}
+		}
+	}
+	if !doctor.OK(results) {
+		os.Exit(1)
+	}
+}
+
+// replay plays a session recorded with `eg run --record` against a fresh build
+// of the app, or the server given with -url, and shows how each response
+// differs from the recording.
+func replay(args []string) {
+	args = args[1:len(args)] // shave off the 'replay' arg
+	if len(args) < 1 {
+		log.Print("ego: Not enough args for `eg replay`. Use `eg help` for more info.")
+		return
+	}
+	processFlags(args)
+	f, err := har.Read(args[0])
+	checkErr(err)
+
+	base, err := url.Parse(fmt.Sprintf("http://localhost:%v", config.Current().Port))
+	checkErr(err)
+	var app proxy.Process
+	if flags["url"] != "" {
+		base, err = url.Parse(flags["url"])
+		checkErr(err)
+	} else {
+		app, err = proxy.Launch()
+		checkErr(err)
+	}
+
+	volatile := append(config.Current().VolatileHeaders, proxy.ActionHeader)
+	client := har.Client()
+	failed := 0
+	for _, e := range f.Log.Entries {
+		r := har.Replay(client, e, base, volatile)
+		u, _ := url.Parse(e.Request.URL)
+		name := e.Request.Method + " " + e.Request.URL
+		if u != nil {
+			name = e.Request.Method + " " + u.RequestURI()
+		}
+		switch {
+		case r.Err != nil:
+			fmt.Printf("ERR  %v: %v\n", name, r.Err)
+		case r.OK():
+			fmt.Printf("ok   %v (%v)\n", name, r.Status)
+		default:
+			fmt.Printf("DIFF %v\n", name)
+			for _, d := range r.Diffs {
+				fmt.Printf("       %v\n", d)
+			}
+		}
+		if !r.OK() {
+			failed++
+		}
+	}
+	fmt.Printf("%v of %v requests matched\n", len(f.Log.Entries) - failed, len(f.Log.Entries))
+	if app != nil {
+		app.Kill()
+	}
+	if failed > 0 {
+		os.Exit(1)
+	}
 }
 
 func help(args []string) {
@@ -301,6 +1185,7 @@ func checkDirs(dirs []string) bool {
 	// }
 	for _, dir := range dirs {
 		if ex, _ := exists(dir); !ex {
+			log.Printf("ego: %v is missing. Run `eg doctor` to check your project.", dir)
 			return false
 		}
 	}
@@ -336,167 +1221,3 @@ func exists(path string) (bool, error) {
     if os.IsNotExist(err) { return false, nil }
     return false, err
 }
-
-// Templates
-func server_go_mustache() []byte {
-	gz, err := gzip.NewReader(bytes.NewBuffer([]byte{
-0x1f,0x8b,0x08,0x00,0x00,0x09,0x6e,0x88,0x00,0xff,0x34,0xcc,
-0x3d,0xca,0x02,0x31,0x10,0x80,0xe1,0x3a,0x73,0x8a,0x61,0xaa,
-0xa4,0xd9,0x14,0x5f,0xf7,0x81,0x57,0xd8,0x42,0x0f,0x20,0x63,
-0x18,0xd7,0x20,0xf9,0x21,0x3f,0x2b,0x18,0x72,0x77,0x17,0xc1,
-0xb7,0x7f,0xde,0xcc,0xee,0xc9,0x9b,0x60,0x60,0x1f,0x01,0x7c,
-0xc8,0xa9,0x34,0xd4,0xa0,0x68,0xf3,0xed,0xd1,0x6f,0x8b,0x4b,
-0xc1,0x86,0x5e,0xde,0x56,0xb6,0x44,0xa0,0xae,0x48,0x63,0xac,
-0x1c,0x64,0x4e,0xcb,0x39,0x5b,0x76,0xcd,0xa7,0x58,0x09,0x0c,
-0xc0,0xbd,0x47,0xf7,0xfd,0x68,0x83,0x03,0xd4,0xce,0x05,0x2b,
-0x9e,0xf0,0x80,0xcb,0x2a,0xaf,0x8b,0x94,0x5d,0x8a,0xfe,0x69,
-0x03,0xaa,0x2e,0xe7,0x1e,0x35,0xfd,0xff,0x1d,0x91,0x81,0xf9,
-0x09,0x00,0x00,0xff,0xff,0xb2,0x01,0x5a,0x7e,0x8b,0x00,0x00,
-0x00,
-	}))
-
-	if err != nil {
-		panic("Decompression failed: " + err.Error())
-	}
-
-	var b bytes.Buffer
-	io.Copy(&b, gz)
-	gz.Close()
-
-	return b.Bytes()
-}
-
-func db_json_mustache() []byte {
-	gz, err := gzip.NewReader(bytes.NewBuffer([]byte{
-0x1f,0x8b,0x08,0x00,0x00,0x09,0x6e,0x88,0x00,0xff,0xaa,0xe6,
-0xe2,0x54,0x4a,0x29,0xca,0x2c,0x4b,0x2d,0x52,0xb2,0x52,0x50,
-0x2a,0xc8,0x2f,0x2e,0x49,0x2f,0x4a,0x2d,0x56,0xd2,0x01,0x0a,
-0xe7,0x25,0xe6,0xa6,0x82,0x04,0x4b,0xf2,0x53,0xf2,0xe3,0x53,
-0x52,0x73,0xf3,0xc1,0xa2,0xa5,0xc5,0x10,0xa5,0xa8,0xa2,0x05,
-0x89,0xc5,0xc5,0xe5,0xf9,0x45,0x29,0x60,0x43,0x60,0x6c,0xae,
-0x5a,0x40,0x00,0x00,0x00,0xff,0xff,0xb8,0x0c,0x60,0x76,0x5e,
-0x00,0x00,0x00,
-	}))
-
-	if err != nil {
-		panic("Decompression failed: " + err.Error())
-	}
-
-	var b bytes.Buffer
-	io.Copy(&b, gz)
-	gz.Close()
-
-	return b.Bytes()
-}
-
-func app_json_mustache() []byte {
-	gz, err := gzip.NewReader(bytes.NewBuffer([]byte{
-0x1f,0x8b,0x08,0x00,0x00,0x09,0x6e,0x88,0x00,0xff,0xaa,0xe6,
-0xe2,0xe4,0xaa,0x05,0x04,0x00,0x00,0xff,0xff,0x23,0x18,0x17,
-0xfc,0x05,0x00,0x00,0x00,
-	}))
-
-	if err != nil {
-		panic("Decompression failed: " + err.Error())
-	}
-
-	var b bytes.Buffer
-	io.Copy(&b, gz)
-	gz.Close()
-
-	return b.Bytes()
-}
-
-func actionfile_go_mustache() []byte {
-	gz, err := gzip.NewReader(bytes.NewBuffer([]byte{
-0x1f,0x8b,0x08,0x00,0x00,0x09,0x6e,0x88,0x00,0xff,0x2a,0x48,
-0x4c,0xce,0x4e,0x4c,0x4f,0x55,0x48,0x4c,0x2e,0xc9,0xcc,0xcf,
-0x2b,0xe6,0xe2,0xca,0xcc,0x2d,0xc8,0x2f,0x2a,0x51,0xd0,0xe0,
-0xe2,0x54,0x4a,0xcf,0x2c,0xc9,0x28,0x4d,0xd2,0x4b,0xce,0xcf,
-0xd5,0xcf,0x2d,0x2d,0xaa,0xd2,0x4f,0x4d,0xcf,0xd7,0x87,0xaa,
-0x53,0xc2,0x21,0x9d,0x51,0x52,0x52,0xa0,0xc4,0xa5,0xc9,0x05,
-0x08,0x00,0x00,0xff,0xff,0x0b,0xc9,0xe7,0xd0,0x57,0x00,0x00,
-0x00,
-	}))
-
-	if err != nil {
-		panic("Decompression failed: " + err.Error())
-	}
-
-	var b bytes.Buffer
-	io.Copy(&b, gz)
-	gz.Close()
-
-	return b.Bytes()
-}
-
-func action_go_mustache() []byte {
-	gz, err := gzip.NewReader(bytes.NewBuffer([]byte{
-0x1f,0x8b,0x08,0x00,0x00,0x09,0x6e,0x88,0x00,0xff,0x3c,0x8e,
-0xc1,0xaa,0x83,0x30,0x10,0x45,0xd7,0xc9,0x57,0x0c,0x2e,0x1e,
-0xfa,0x10,0xdd,0x0b,0x5d,0x14,0xda,0x45,0x17,0xd5,0x22,0xfd,
-0x81,0xa0,0xd3,0x46,0xd0,0xa8,0xc9,0xa4,0x14,0xc2,0xfc,0x7b,
-0xad,0x4a,0x77,0x77,0x0e,0x77,0x0e,0xf7,0xa5,0x2c,0x84,0x50,
-0xaa,0x01,0x99,0xe1,0x00,0xaa,0xa1,0x6e,0x34,0x2e,0xab,0xf1,
-0xd9,0x39,0x42,0x1b,0xff,0x69,0xa2,0x29,0x3b,0xae,0x38,0x48,
-0x71,0x53,0xa4,0x0b,0x88,0x42,0xf8,0x06,0xe6,0x28,0x95,0xe2,
-0x8a,0xa4,0xc7,0x76,0x85,0x5b,0xdc,0xf0,0xf9,0x8d,0x8d,0x27,
-0x2c,0xe0,0xe1,0x4d,0x03,0xb1,0xc5,0x19,0xfe,0x57,0x57,0x8d,
-0xb3,0x47,0x47,0x09,0xec,0x97,0xf3,0x3d,0xc1,0xa2,0x16,0x79,
-0x0e,0xf7,0xea,0x54,0x15,0x70,0x19,0xa6,0x1e,0x07,0x34,0x04,
-0xa4,0x3b,0xb7,0x6f,0xca,0x96,0x86,0x45,0xf2,0xd6,0x6c,0x8f,
-0xe5,0x48,0xbf,0x1e,0xb6,0x52,0x70,0x2a,0x39,0xf9,0x04,0x00,
-0x00,0xff,0xff,0x71,0xb1,0xcf,0x2d,0xce,0x00,0x00,0x00,
-	}))
-
-	if err != nil {
-		panic("Decompression failed: " + err.Error())
-	}
-
-	var b bytes.Buffer
-	io.Copy(&b, gz)
-	gz.Close()
-
-	return b.Bytes()
-}
-
-func error_html_mustache() []byte {
-	gz, err := gzip.NewReader(bytes.NewBuffer([]byte{
-0x1f,0x8b,0x08,0x00,0x00,0x09,0x6e,0x88,0x00,0xff,0x54,0x51,
-0xc1,0x6e,0xeb,0x20,0x10,0x3c,0xfb,0x7d,0x05,0xcf,0xbd,0xb4,
-0x92,0x1d,0xdb,0x72,0xdd,0x83,0x43,0x23,0xf5,0xd6,0x4b,0xfb,
-0x0f,0x1b,0xd8,0x60,0x54,0x0c,0x16,0x90,0x36,0x6e,0x94,0x7f,
-0x2f,0x98,0xb4,0x49,0x64,0x09,0x34,0x33,0xec,0x7a,0x76,0x96,
-0xfe,0xe7,0x86,0xf9,0x79,0x42,0x32,0xf8,0x51,0x6d,0xfe,0xd1,
-0x74,0x65,0x74,0x40,0xe0,0xe1,0xce,0xa8,0x97,0x5e,0xe1,0xe6,
-0x78,0x7c,0x43,0xe7,0x40,0xe0,0xe9,0x44,0xab,0x44,0x45,0xd1,
-0xf9,0x59,0x21,0x89,0xf5,0xcf,0xb9,0xc7,0x83,0xaf,0x98,0x73,
-0x79,0x54,0xb2,0xd8,0xa7,0x20,0x5b,0xc3,0x67,0x72,0x8c,0x38,
-0xdb,0x02,0xfb,0x10,0xd6,0xec,0x35,0xef,0xc9,0x1d,0x22,0xae,
-0x17,0x76,0x02,0xce,0xa5,0x16,0x3d,0xa9,0x13,0x1e,0xc1,0x0a,
-0xa9,0x7f,0xe1,0x29,0x1e,0x57,0x3d,0x76,0x46,0xfb,0x72,0x07,
-0xa3,0x54,0x73,0x4f,0xf2,0x57,0x54,0x9f,0xe8,0x25,0x03,0xf2,
-0x8e,0x7b,0xcc,0x8b,0x17,0x2b,0x41,0x15,0x0e,0xb4,0x2b,0x1d,
-0x5a,0xb9,0x4b,0x1d,0x99,0x51,0xc6,0x86,0x5f,0xb6,0xf0,0xd8,
-0x76,0xed,0x2d,0xd7,0x75,0x5d,0x22,0xa2,0xf7,0xd2,0x0d,0xc0,
-0xcd,0x57,0x5f,0x93,0x76,0x3a,0x90,0xa6,0x0e,0x87,0x15,0x5b,
-0xb8,0xaf,0x8b,0xe5,0x5b,0xb5,0x0f,0x57,0x6f,0x41,0x49,0x11,
-0x6c,0x32,0xd4,0x1e,0xed,0xc5,0xeb,0xd0,0x9c,0x9d,0x2a,0xf4,
-0x41,0x28,0xdd,0x04,0x6c,0x19,0xaf,0xac,0x57,0x0d,0x8e,0xeb,
-0xcb,0x14,0x4e,0x7e,0x63,0xdf,0x3d,0x4d,0x87,0xbf,0x62,0x5a,
-0x2d,0x69,0xc6,0xf0,0xab,0x73,0xfa,0x34,0xce,0xbe,0x04,0x3d,
-0x34,0xb7,0x2b,0x08,0x38,0xbe,0x4b,0x7a,0x80,0x71,0x6b,0x3f,
-0x01,0x00,0x00,0xff,0xff,0x61,0xd4,0x4f,0xc1,0xcc,0x01,0x00,
-0x00,
-	}))
-
-	if err != nil {
-		panic("Decompression failed: " + err.Error())
-	}
-
-	var b bytes.Buffer
-	io.Copy(&b, gz)
-	gz.Close()
-
-	return b.Bytes()
-}
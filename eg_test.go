@@ -0,0 +1,194 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/murz/eg/logger"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+func TestCommandArgs(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"run"}, "run"},
+		{[]string{"-v", "run"}, "run"},
+		{[]string{"-q", "--env", "test", "test", "-run", "TestX"}, "test -run TestX"},
+		{[]string{"--log=json", "--", "build"}, "build"},
+		{[]string{"-v"}, ""},
+		{[]string{"--env"}, ""},
+		{nil, ""},
+	}
+	for _, test := range tests {
+		if got := strings.Join(commandArgs(test.args), " "); got != test.want {
+			t.Errorf("commandArgs(%q) = %q, want %q", test.args, got, test.want)
+		}
+	}
+}
+
+func TestProcessFlags(t *testing.T) {
+	tests := []struct {
+		args []string
+		want map[string]string
+	}{
+		{[]string{"-path", "/posts"}, map[string]string{"path": "/posts"}},
+		{[]string{"--method=POST"}, map[string]string{"method": "POST"}},
+		{[]string{"posts", "-file", "posts.go", "extra"}, map[string]string{"file": "posts.go"}},
+		{[]string{"--watch"}, map[string]string{"watch": "true"}},
+		{[]string{"--watch", "-run", "TestX"}, map[string]string{"watch": "true", "run": "TestX"}},
+		{[]string{"config", "set", "port", ""}, map[string]string{}},
+		{[]string{"-file", ""}, map[string]string{"file": ""}},
+		{[]string{"-", "--", "-path", "-"}, map[string]string{"path": "-"}},
+		{[]string{"-v", "run"}, map[string]string{"v": "true"}},
+		{[]string{"-q", "test", "--watch", "./app/..."}, map[string]string{"q": "true", "watch": "true"}},
+		{[]string{"doctor", "--json=false"}, map[string]string{"json": "false"}},
+	}
+	for _, test := range tests {
+		flags = map[string]string{}
+		processFlags(test.args)
+		for k, v := range test.want {
+			if flags[k] != v {
+				t.Errorf("processFlags(%q): flags[%q] = %q, want %q", test.args, k, flags[k], v)
+			}
+		}
+		if len(flags) != len(test.want) {
+			t.Errorf("processFlags(%q) = %v, want %v", test.args, flags, test.want)
+		}
+	}
+}
+
+func TestQuietLoggingShowsProblems(t *testing.T) {
+	var buf bytes.Buffer
+	logger.SetOutput(&buf)
+	flags = map[string]string{"q": "true"}
+	setupLogging()
+	defer func() {
+		logger.SetOutput(os.Stderr)
+		logger.SetLevel(logger.Info)
+	}()
+	log.Print("Controller 'posts', was successfully created")
+	log.Print("ego: Not enough args for `eg new controller`.")
+	if got := buf.String(); strings.Contains(got, "successfully") || !strings.Contains(got, "ERROR ego: Not enough args") {
+		t.Errorf("logged %q, want just the problem, as an error", got)
+	}
+}
+
+func TestGenerators(t *testing.T) {
+	tests := []struct {
+		name string
+		cmds [][]string
+	}{
+		{"app_html", [][]string{{"new", "app", "demo", "-go", "1.21"}}},
+		{"app_api", [][]string{{"new", "app", "demo", "-go", "1.21", "--template", "api"}}},
+		{"app_minimal", [][]string{{"new", "app", "demo", "-go", "1.21", "--template", "minimal", "--module", "example.com/demo"}}},
+		{"controller", [][]string{{"new", "app", "demo", "-go", "1.21", "--template", "minimal"}, {"cd", "demo"}, {"new", "controller", "posts"}}},
+		{"model", [][]string{{"new", "app", "demo", "-go", "1.21", "--template", "minimal"}, {"cd", "demo"}, {"new", "model", "post", "title:string", "published_at:*time.Time"}, {"new", "model", "event", "name:string", "--db", "analytics"}}},
+	}
+	// The packs' hooks run `go get`, which would need the network, so a fake go
+	// records them instead.
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake go is a shell script")
+	}
+	bin := t.TempDir()
+	goArgs := filepath.Join(bin, "args")
+	ioutil.WriteFile(filepath.Join(bin, "go"), []byte("#!/bin/sh\necho \"$@\" >> \"$GO_ARGS\"\n"), 0777)
+	t.Setenv("PATH", bin+string(os.PathListSeparator)+os.Getenv("PATH"))
+	t.Setenv("GO_ARGS", goArgs)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			os.Remove(goArgs)
+			golden, err := filepath.Abs(filepath.Join("testdata", test.name+".golden"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			dir := t.TempDir()
+			// Keep the user's template overrides out of the output.
+			t.Setenv("HOME", dir)
+			chdir(t, dir)
+			for _, cmd := range test.cmds {
+				flags = map[string]string{}
+				if cmd[0] == "cd" {
+					chdir(t, cmd[1])
+					continue
+				}
+				new(cmd)
+			}
+			checkGolden(t, golden, tree(t, filepath.Join(dir, "demo")))
+			ran, _ := ioutil.ReadFile(goArgs)
+			if want := "get github.com/murz/ego@latest github.com/murz/eg@latest\n"; string(ran) != want {
+				t.Errorf("new app ran go %q, want %q", ran, want)
+			}
+		})
+	}
+}
+
+// chdir changes the working directory until the end of the test.
+func chdir(t *testing.T, dir string) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		os.Chdir(wd)
+	})
+}
+
+// tree returns the directories and files under dir, with the contents of the
+// files, in a form that's easy to read in a golden file.
+func tree(t *testing.T, dir string) string {
+	var b strings.Builder
+	err := filepath.Walk(dir, func(path string, fi os.FileInfo, err error) error {
+		if err != nil || path == dir {
+			return err
+		}
+		rel, _ := filepath.Rel(dir, path)
+		rel = filepath.ToSlash(rel)
+		if fi.IsDir() {
+			b.WriteString("-- " + rel + "/ --\n")
+			return nil
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		b.WriteString("-- " + rel + " --\n")
+		b.Write(data)
+		if len(data) > 0 && data[len(data)-1] != '\n' {
+			b.WriteString("\n")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b.String()
+}
+
+func checkGolden(t *testing.T, golden string, got string) {
+	if *update {
+		if err := ioutil.WriteFile(golden, []byte(got), 0666); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := ioutil.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("%v (run the tests with -update to create it)", err)
+	}
+	if got != string(want) {
+		t.Errorf("output doesn't match %v (run the tests with -update to accept it)\ngot:\n%v\nwant:\n%v", golden, got, string(want))
+	}
+}
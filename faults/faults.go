@@ -0,0 +1,252 @@
+// Package faults provides the fault injection rules of the dev proxy: added
+// latency, injected errors, dropped connections and throttled responses for
+// the requests that match a path glob and method. Rules are changed while eg
+// runs, from /_eg/faults or `eg faults set`.
+package faults
+
+import (
+  "fmt"
+  "math/rand"
+  "net/http"
+  "net/url"
+  "regexp"
+  "strconv"
+  "strings"
+  "sync"
+  "time"
+)
+
+type Rule struct {
+  // Path is a glob: * matches within a segment and ** across segments.
+  Path string `json:"path"`
+  Method string `json:"method,omitempty"`
+  Latency Duration `json:"latency,omitempty"`
+  Jitter Duration `json:"jitter,omitempty"`
+  // ErrorRate and DropRate are percentages of matching requests.
+  ErrorRate float64 `json:"error_rate,omitempty"`
+  ErrorStatus int `json:"error_status,omitempty"`
+  DropRate float64 `json:"drop_rate,omitempty"`
+  // Bandwidth limits responses to that many bytes a second.
+  Bandwidth int `json:"bandwidth,omitempty"`
+  pattern *regexp.Regexp
+}
+
+// Duration is a time.Duration that reads and writes as "200ms".
+type Duration time.Duration
+
+func (d Duration) MarshalText() ([]byte, error) {
+  return []byte(time.Duration(d).String()), nil
+}
+
+func (d *Duration) UnmarshalText(text []byte) error {
+  v, err := time.ParseDuration(string(text))
+  *d = Duration(v)
+  return err
+}
+
+// random picks a number in [0, 1) for the rates and the jitter.
+var random = rand.Float64
+
+// Parse makes a rule from form values: path, method, latency, jitter, errors
+// (a percentage), status, drop (a percentage) and bandwidth (bytes a second).
+func Parse(v url.Values) (*Rule, error) {
+  r := &Rule{
+    Path: v.Get("path"),
+    Method: strings.ToUpper(v.Get("method")),
+  }
+  if r.Path == "" {
+    r.Path = "/**"
+  }
+  var err error
+  durations := map[string]*Duration{"latency": &r.Latency, "jitter": &r.Jitter}
+  for key, d := range durations {
+    if s := v.Get(key); s != "" {
+      if err = d.UnmarshalText([]byte(s)); err != nil {
+        return nil, fmt.Errorf("faults: bad %v %q", key, s)
+      }
+    }
+  }
+  floats := map[string]*float64{"errors": &r.ErrorRate, "drop": &r.DropRate}
+  for key, f := range floats {
+    if s := v.Get(key); s != "" {
+      if *f, err = strconv.ParseFloat(strings.TrimSuffix(s, "%"), 64); err != nil || *f < 0 || *f > 100 {
+        return nil, fmt.Errorf("faults: bad %v %q, want a percentage", key, s)
+      }
+    }
+  }
+  ints := map[string]*int{"status": &r.ErrorStatus, "bandwidth": &r.Bandwidth}
+  for key, i := range ints {
+    if s := v.Get(key); s != "" {
+      if *i, err = strconv.Atoi(s); err != nil || *i < 0 {
+        return nil, fmt.Errorf("faults: bad %v %q", key, s)
+      }
+    }
+  }
+  return r, r.compile()
+}
+
+func (r *Rule) compile() error {
+  expr := regexp.QuoteMeta(r.Path)
+  expr = strings.Replace(expr, `\*\*`, ".*", -1)
+  expr = strings.Replace(expr, `\*`, "[^/]*", -1)
+  expr = strings.Replace(expr, `\?`, "[^/]", -1)
+  pattern, err := regexp.Compile("^" + expr + "$")
+  if err != nil {
+    return fmt.Errorf("faults: bad path %q", r.Path)
+  }
+  r.pattern = pattern
+  return nil
+}
+
+// Matches reports whether req is in the rule's scope.
+func (r *Rule) Matches(req *http.Request) bool {
+  if r.Method != "" && r.Method != req.Method {
+    return false
+  }
+  return r.pattern.MatchString(req.URL.Path)
+}
+
+// Delay returns how long to hold a request: the latency plus up to the jitter.
+func (r *Rule) Delay() time.Duration {
+  return time.Duration(r.Latency) + time.Duration(random() * float64(r.Jitter))
+}
+
+// Fail reports whether to answer with an error, and which status.
+func (r *Rule) Fail() (bool, int) {
+  if random() * 100 >= r.ErrorRate {
+    return false, 0
+  }
+  return true, r.status()
+}
+
+func (r *Rule) status() int {
+  if r.ErrorStatus == 0 {
+    return http.StatusServiceUnavailable
+  }
+  return r.ErrorStatus
+}
+
+// Drop reports whether to close the connection without answering.
+func (r *Rule) Drop() bool {
+  return random() * 100 < r.DropRate
+}
+
+func (r *Rule) String() string {
+  scope := r.Path
+  if r.Method != "" {
+    scope = r.Method + " " + scope
+  }
+  faults := make([]string, 0)
+  if r.Latency > 0 || r.Jitter > 0 {
+    faults = append(faults, fmt.Sprintf("latency %v±%v", time.Duration(r.Latency), time.Duration(r.Jitter)))
+  }
+  if r.ErrorRate > 0 {
+    faults = append(faults, fmt.Sprintf("%v%% %v", r.ErrorRate, r.status()))
+  }
+  if r.DropRate > 0 {
+    faults = append(faults, fmt.Sprintf("%v%% dropped", r.DropRate))
+  }
+  if r.Bandwidth > 0 {
+    faults = append(faults, fmt.Sprintf("%v B/s", r.Bandwidth))
+  }
+  if len(faults) == 0 {
+    faults = append(faults, "nothing")
+  }
+  return scope + ": " + strings.Join(faults, ", ")
+}
+
+// Set is the rules in effect. The first matching rule applies.
+type Set struct {
+  sync.RWMutex
+  rules []*Rule
+}
+
+func NewSet() *Set {
+  return &Set{rules: make([]*Rule, 0)}
+}
+
+// Add adds r, replacing the rule for the same path and method.
+func (s *Set) Add(r *Rule) error {
+  if err := r.compile(); err != nil {
+    return err
+  }
+  s.Lock()
+  defer s.Unlock()
+  for i, old := range s.rules {
+    if old.Path == r.Path && old.Method == r.Method {
+      s.rules[i] = r
+      return nil
+    }
+  }
+  s.rules = append(s.rules, r)
+  return nil
+}
+
+// Remove removes the i'th rule.
+func (s *Set) Remove(i int) {
+  s.Lock()
+  defer s.Unlock()
+  if i >= 0 && i < len(s.rules) {
+    s.rules = append(s.rules[0:i], s.rules[i+1:]...)
+  }
+}
+
+func (s *Set) Clear() {
+  s.Lock()
+  defer s.Unlock()
+  s.rules = make([]*Rule, 0)
+}
+
+func (s *Set) Rules() []*Rule {
+  s.RLock()
+  defer s.RUnlock()
+  return append([]*Rule{}, s.rules...)
+}
+
+// Find returns the rule for req, or nil.
+func (s *Set) Find(req *http.Request) *Rule {
+  s.RLock()
+  defer s.RUnlock()
+  for _, r := range s.rules {
+    if r.Matches(req) {
+      return r
+    }
+  }
+  return nil
+}
+
+// Throttle returns a ResponseWriter that writes to w at no more than bps
+// bytes a second.
+func Throttle(w http.ResponseWriter, bps int) http.ResponseWriter {
+  return &throttled{ResponseWriter: w, bps: bps}
+}
+
+type throttled struct {
+  http.ResponseWriter
+  bps int
+}
+
+func (t *throttled) Write(data []byte) (int, error) {
+  // Write a tenth of a second's worth at a time.
+  chunk := t.bps / 10
+  if chunk < 1 {
+    chunk = 1
+  }
+  written := 0
+  for written < len(data) {
+    end := written + chunk
+    if end > len(data) {
+      end = len(data)
+    }
+    n, err := t.ResponseWriter.Write(data[written:end])
+    written += n
+    if err != nil {
+      return written, err
+    }
+    if f, ok := t.ResponseWriter.(http.Flusher); ok {
+      f.Flush()
+    }
+    time.Sleep(time.Duration(float64(n) / float64(t.bps) * float64(time.Second)))
+  }
+  return written, nil
+}
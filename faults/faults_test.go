@@ -0,0 +1,78 @@
+package faults
+
+import (
+  "net/http/httptest"
+  "net/url"
+  "testing"
+  "time"
+)
+
+func TestParseAndMatch(t *testing.T) {
+  r, err := Parse(url.Values{"path": {"/api/**"}, "method": {"post"}, "latency": {"200ms"}, "errors": {"10%"}})
+  if err != nil {
+    t.Fatal(err)
+  }
+  if r.String() != "POST /api/**: latency 200ms±0s, 10% 503" {
+    t.Errorf("String() = %q", r.String())
+  }
+  tests := []struct {
+    method string
+    path string
+    want bool
+  }{
+    {"POST", "/api/users", true},
+    {"POST", "/api/users/1/posts", true},
+    {"GET", "/api/users", false},
+    {"POST", "/apix", false},
+  }
+  for _, test := range tests {
+    if got := r.Matches(httptest.NewRequest(test.method, test.path, nil)); got != test.want {
+      t.Errorf("%v %v matched = %v, want %v", test.method, test.path, got, test.want)
+    }
+  }
+
+  for _, bad := range []url.Values{{"latency": {"soon"}}, {"errors": {"150"}}, {"bandwidth": {"-1"}}} {
+    if _, err := Parse(bad); err == nil {
+      t.Errorf("Parse(%v) didn't fail", bad)
+    }
+  }
+}
+
+func TestRates(t *testing.T) {
+  defer func(old func() float64) { random = old }(random)
+  r := &Rule{Latency: Duration(100 * time.Millisecond), Jitter: Duration(50 * time.Millisecond), ErrorRate: 25, ErrorStatus: 500, DropRate: 5}
+
+  random = func() float64 { return 0.2 }
+  if d := r.Delay(); d != 110 * time.Millisecond {
+    t.Errorf("Delay() = %v, want 110ms", d)
+  }
+  if fail, status := r.Fail(); !fail || status != 500 {
+    t.Errorf("Fail() = %v, %v; want true, 500", fail, status)
+  }
+  if r.Drop() {
+    t.Errorf("Drop() = true at 20%%, want false at a 5%% rate")
+  }
+
+  random = func() float64 { return 0.3 }
+  if fail, _ := r.Fail(); fail {
+    t.Errorf("Fail() = true at 30%%, want false at a 25%% rate")
+  }
+}
+
+func TestSetReplacesSameScope(t *testing.T) {
+  s := NewSet()
+  s.Add(&Rule{Path: "/a/*", Latency: Duration(time.Second)})
+  s.Add(&Rule{Path: "/b"})
+  s.Add(&Rule{Path: "/a/*", ErrorRate: 50})
+  rules := s.Rules()
+  if len(rules) != 2 || rules[0].ErrorRate != 50 || rules[0].Latency != 0 {
+    t.Errorf("rules = %v", rules)
+  }
+  if s.Find(httptest.NewRequest("GET", "/a/x", nil)) != rules[0] {
+    t.Errorf("Find didn't return the /a/* rule")
+  }
+  s.Remove(0)
+  if s.Find(httptest.NewRequest("GET", "/a/x", nil)) != nil {
+    t.Errorf("the removed rule still matches")
+  }
+}
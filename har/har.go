@@ -0,0 +1,265 @@
+// Package har provides reading and writing of HTTP Archive (HAR 1.2) files,
+// which `eg run --record` saves sessions to and `eg replay` plays back.
+package har
+
+import (
+  "encoding/base64"
+  "encoding/json"
+  "fmt"
+  "io"
+  "io/ioutil"
+  "net/http"
+  "net/url"
+  "os"
+  "sort"
+  "sync"
+  "time"
+  "unicode/utf8"
+)
+
+type File struct {
+  Log Log `json:"log"`
+}
+
+type Log struct {
+  Version string `json:"version"`
+  Creator Creator `json:"creator"`
+  Entries []Entry `json:"entries"`
+}
+
+type Creator struct {
+  Name string `json:"name"`
+  Version string `json:"version"`
+}
+
+type Entry struct {
+  StartedDateTime time.Time `json:"startedDateTime"`
+  Time float64 `json:"time"`
+  Request Request `json:"request"`
+  Response Response `json:"response"`
+  Cache struct{} `json:"cache"`
+  Timings Timings `json:"timings"`
+  Comment string `json:"comment,omitempty"`
+}
+
+type Request struct {
+  Method string `json:"method"`
+  URL string `json:"url"`
+  HTTPVersion string `json:"httpVersion"`
+  Cookies []Cookie `json:"cookies"`
+  Headers []Header `json:"headers"`
+  QueryString []Header `json:"queryString"`
+  PostData *PostData `json:"postData,omitempty"`
+  HeadersSize int `json:"headersSize"`
+  BodySize int `json:"bodySize"`
+}
+
+type Response struct {
+  Status int `json:"status"`
+  StatusText string `json:"statusText"`
+  HTTPVersion string `json:"httpVersion"`
+  Cookies []Cookie `json:"cookies"`
+  Headers []Header `json:"headers"`
+  Content Content `json:"content"`
+  RedirectURL string `json:"redirectURL"`
+  HeadersSize int `json:"headersSize"`
+  BodySize int `json:"bodySize"`
+}
+
+// Header is a name/value pair, used for headers and query strings.
+type Header struct {
+  Name string `json:"name"`
+  Value string `json:"value"`
+}
+
+type Cookie struct {
+  Name string `json:"name"`
+  Value string `json:"value"`
+}
+
+// PostData and Content are Truncated when only the start of the body was
+// recorded. Replay doesn't compare the bodies of such entries.
+type PostData struct {
+  MimeType string `json:"mimeType"`
+  Text string `json:"text"`
+  Truncated bool `json:"_truncated,omitempty"`
+}
+
+type Content struct {
+  Size int `json:"size"`
+  MimeType string `json:"mimeType"`
+  Text string `json:"text,omitempty"`
+  Encoding string `json:"encoding,omitempty"`
+  Truncated bool `json:"_truncated,omitempty"`
+}
+
+type Timings struct {
+  Send float64 `json:"send"`
+  Wait float64 `json:"wait"`
+  Receive float64 `json:"receive"`
+}
+
+// Headers converts h to HAR headers, sorted by name.
+func Headers(h http.Header) []Header {
+  list := make([]Header, 0, len(h))
+  for name, vals := range h {
+    for _, v := range vals {
+      list = append(list, Header{name, v})
+    }
+  }
+  sort.SliceStable(list, func(i, j int) bool {
+    return list[i].Name < list[j].Name
+  })
+  return list
+}
+
+// HTTPHeader converts HAR headers back to an http.Header.
+func HTTPHeader(headers []Header) http.Header {
+  h := make(http.Header)
+  for _, hdr := range headers {
+    h.Add(hdr.Name, hdr.Value)
+  }
+  return h
+}
+
+// NewEntry describes an exchange: a request to rawurl and the response the
+// app gave, taking d.
+func NewEntry(started time.Time, d time.Duration, method string, rawurl string, reqHeader http.Header, reqBody []byte, status int, respHeader http.Header, respBody []byte) Entry {
+  ms := float64(d) / float64(time.Millisecond)
+  e := Entry{
+    StartedDateTime: started,
+    Time: ms,
+    Request: Request{
+      Method: method,
+      URL: rawurl,
+      HTTPVersion: "HTTP/1.1",
+      Cookies: make([]Cookie, 0),
+      Headers: Headers(reqHeader),
+      QueryString: make([]Header, 0),
+      HeadersSize: -1,
+      BodySize: len(reqBody),
+    },
+    Response: Response{
+      Status: status,
+      StatusText: http.StatusText(status),
+      HTTPVersion: "HTTP/1.1",
+      Cookies: make([]Cookie, 0),
+      Headers: Headers(respHeader),
+      Content: Content{
+        Size: len(respBody),
+        MimeType: respHeader.Get("Content-Type"),
+      },
+      RedirectURL: respHeader.Get("Location"),
+      HeadersSize: -1,
+      BodySize: len(respBody),
+    },
+    Timings: Timings{Wait: ms},
+  }
+  if u, err := url.Parse(rawurl); err == nil {
+    for name, vals := range u.Query() {
+      for _, v := range vals {
+        e.Request.QueryString = append(e.Request.QueryString, Header{name, v})
+      }
+    }
+  }
+  for _, c := range (&http.Request{Header: reqHeader}).Cookies() {
+    e.Request.Cookies = append(e.Request.Cookies, Cookie{c.Name, c.Value})
+  }
+  for _, c := range (&http.Response{Header: respHeader}).Cookies() {
+    e.Response.Cookies = append(e.Response.Cookies, Cookie{c.Name, c.Value})
+  }
+  if len(reqBody) > 0 {
+    e.Request.PostData = &PostData{
+      MimeType: reqHeader.Get("Content-Type"),
+      Text: string(reqBody),
+    }
+  }
+  if utf8.Valid(respBody) {
+    e.Response.Content.Text = string(respBody)
+  } else {
+    e.Response.Content.Text = base64.StdEncoding.EncodeToString(respBody)
+    e.Response.Content.Encoding = "base64"
+  }
+  return e
+}
+
+// Body returns the decoded response body.
+func (c Content) Body() ([]byte, error) {
+  if c.Encoding == "base64" {
+    return base64.StdEncoding.DecodeString(c.Text)
+  }
+  return []byte(c.Text), nil
+}
+
+// Read loads a HAR file.
+func Read(filename string) (*File, error) {
+  data, err := ioutil.ReadFile(filename)
+  if err != nil {
+    return nil, err
+  }
+  f := &File{}
+  return f, json.Unmarshal(data, f)
+}
+
+// Write saves f to filename.
+func Write(filename string, f *File) error {
+  data, err := json.MarshalIndent(f, "", "  ")
+  if err != nil {
+    return err
+  }
+  return ioutil.WriteFile(filename, data, 0666)
+}
+
+// recordTrailer closes the entries of a file being recorded.
+const recordTrailer = "\n    ]\n  }\n}\n"
+
+// Recorder appends entries to a HAR file. Each entry is written over the end
+// of the file and followed by a new one, so the file is complete whenever eg
+// is stopped without being rewritten as it grows. The file is created on the
+// first entry.
+type Recorder struct {
+  sync.Mutex
+  Filename string
+  file *os.File
+}
+
+func NewRecorder(filename string) *Recorder {
+  return &Recorder{Filename: filename}
+}
+
+func (r *Recorder) Add(e Entry) error {
+  r.Lock()
+  defer r.Unlock()
+  data, err := json.MarshalIndent(e, "      ", "  ")
+  if err != nil {
+    return err
+  }
+  sep := ","
+  if r.file == nil {
+    f, err := os.Create(r.Filename)
+    if err != nil {
+      return err
+    }
+    creator, _ := json.Marshal(Creator{"eg", "0.1"})
+    if _, err := fmt.Fprintf(f, "{\n  \"log\": {\n    \"version\": \"1.2\",\n    \"creator\": %s,\n    \"entries\": [", creator); err != nil {
+      f.Close()
+      return err
+    }
+    r.file = f
+    sep = ""
+  } else if _, err := r.file.Seek(-int64(len(recordTrailer)), io.SeekEnd); err != nil {
+    return err
+  }
+  _, err = fmt.Fprintf(r.file, "%v\n      %s%v", sep, data, recordTrailer)
+  return err
+}
+
+// Close closes the file being recorded to. Entries can't be added after.
+func (r *Recorder) Close() error {
+  r.Lock()
+  defer r.Unlock()
+  if r.file == nil {
+    return nil
+  }
+  return r.file.Close()
+}
@@ -0,0 +1,219 @@
+package har
+
+import (
+  "bytes"
+  "compress/flate"
+  "compress/gzip"
+  "compress/zlib"
+  "fmt"
+  "io"
+  "io/ioutil"
+  "net/http"
+  "net/url"
+  "sort"
+  "strings"
+)
+
+// skipHeaders aren't sent when replaying a request, or are set by the client
+// itself. Accept-Encoding is dropped so bodies come back uncompressed.
+var skipHeaders = map[string]bool{
+  "Host": true,
+  "Content-Length": true,
+  "Connection": true,
+  "Accept-Encoding": true,
+  "Transfer-Encoding": true,
+  "Keep-Alive": true,
+}
+
+// maxDiffLines is the most lines of a body that are diffed line by line.
+const maxDiffLines = 2000
+
+// Result is the outcome of replaying one entry.
+type Result struct {
+  Entry Entry
+  Status int
+  Diffs []string
+  Err error
+}
+
+func (r Result) OK() bool {
+  return r.Err == nil && len(r.Diffs) == 0
+}
+
+// Client returns an http.Client for replaying: redirects are compared, not
+// followed.
+func Client() *http.Client {
+  return &http.Client{
+    CheckRedirect: func(req *http.Request, via []*http.Request) error {
+      return http.ErrUseLastResponse
+    },
+  }
+}
+
+// Replay sends the recorded request to the host in base and compares the
+// response with the recorded one. Headers named in volatile are ignored.
+func Replay(client *http.Client, e Entry, base *url.URL, volatile []string) Result {
+  res := Result{Entry: e}
+  u, err := url.Parse(e.Request.URL)
+  if err != nil {
+    res.Err = err
+    return res
+  }
+  u.Scheme = base.Scheme
+  u.Host = base.Host
+  body := ""
+  if e.Request.PostData != nil {
+    body = e.Request.PostData.Text
+  }
+  req, err := http.NewRequest(e.Request.Method, u.String(), strings.NewReader(body))
+  if err != nil {
+    res.Err = err
+    return res
+  }
+  for _, h := range e.Request.Headers {
+    if !skipHeaders[http.CanonicalHeaderKey(h.Name)] {
+      req.Header.Add(h.Name, h.Value)
+    }
+  }
+  resp, err := client.Do(req)
+  if err != nil {
+    res.Err = err
+    return res
+  }
+  defer resp.Body.Close()
+  got, err := ioutil.ReadAll(resp.Body)
+  if err != nil {
+    res.Err = err
+    return res
+  }
+  res.Status = resp.StatusCode
+  want, err := e.Response.Content.Body()
+  if err != nil {
+    res.Err = err
+    return res
+  }
+  if e.Response.Content.Truncated || (e.Request.PostData != nil && e.Request.PostData.Truncated) {
+    // Only the start of a body was recorded, so the bodies can't be compared.
+    want, got = nil, nil
+  } else {
+    if want, err = Decode(HTTPHeader(e.Response.Headers).Get("Content-Encoding"), want); err != nil {
+      res.Err = fmt.Errorf("recorded body: %v", err)
+      return res
+    }
+    if got, err = Decode(resp.Header.Get("Content-Encoding"), got); err != nil {
+      res.Err = err
+      return res
+    }
+  }
+  res.Diffs = Compare(e.Response.Status, HTTPHeader(e.Response.Headers), want, resp.StatusCode, resp.Header, got, volatile)
+  return res
+}
+
+// Decode undoes the content encoding of a body. Encodings it doesn't know are
+// left as they are.
+func Decode(encoding string, body []byte) ([]byte, error) {
+  var r io.Reader
+  switch strings.ToLower(strings.TrimSpace(encoding)) {
+  case "gzip", "x-gzip":
+    zr, err := gzip.NewReader(bytes.NewReader(body))
+    if err != nil {
+      return nil, err
+    }
+    r = zr
+  case "deflate":
+    // deflate is meant to be zlib wrapped, but some servers send it raw.
+    zr, err := zlib.NewReader(bytes.NewReader(body))
+    if err != nil {
+      zr = flate.NewReader(bytes.NewReader(body))
+    }
+    r = zr
+  default:
+    return body, nil
+  }
+  return ioutil.ReadAll(r)
+}
+
+// Compare describes the differences between a recorded response and a new
+// one, ignoring the volatile headers. The bodies are compared decoded, so
+// Content-Encoding is ignored too.
+func Compare(wantStatus int, wantHeader http.Header, want []byte, gotStatus int, gotHeader http.Header, got []byte, volatile []string) []string {
+  diffs := make([]string, 0)
+  if wantStatus != gotStatus {
+    diffs = append(diffs, fmt.Sprintf("status: %v -> %v", wantStatus, gotStatus))
+  }
+
+  ignored := make(map[string]bool)
+  for _, name := range volatile {
+    ignored[http.CanonicalHeaderKey(name)] = true
+  }
+  names := make(map[string]bool)
+  for name := range wantHeader {
+    names[name] = true
+  }
+  for name := range gotHeader {
+    names[name] = true
+  }
+  sorted := make([]string, 0)
+  for name := range names {
+    if !ignored[name] && !skipHeaders[name] && name != "Content-Encoding" {
+      sorted = append(sorted, name)
+    }
+  }
+  sort.Strings(sorted)
+  for _, name := range sorted {
+    w := strings.Join(wantHeader[name], ", ")
+    g := strings.Join(gotHeader[name], ", ")
+    if w != g {
+      diffs = append(diffs, fmt.Sprintf("header %v: %q -> %q", name, w, g))
+    }
+  }
+
+  if !bytes.Equal(want, got) {
+    diffs = append(diffs, fmt.Sprintf("body: %v bytes -> %v bytes", len(want), len(got)))
+    diffs = append(diffs, DiffLines(string(want), string(got))...)
+  }
+  return diffs
+}
+
+// DiffLines returns the lines removed from a ("- ") and added in b ("+ "), in
+// order. Large bodies aren't diffed.
+func DiffLines(a string, b string) []string {
+  x := strings.Split(a, "\n")
+  y := strings.Split(b, "\n")
+  if len(x) > maxDiffLines || len(y) > maxDiffLines {
+    return []string{}
+  }
+  // lcs[i][j] is the length of the longest common subsequence of x[i:] and
+  // y[j:].
+  lcs := make([][]int, len(x) + 1)
+  for i := range lcs {
+    lcs[i] = make([]int, len(y) + 1)
+  }
+  for i := len(x) - 1; i >= 0; i-- {
+    for j := len(y) - 1; j >= 0; j-- {
+      if x[i] == y[j] {
+        lcs[i][j] = lcs[i+1][j+1] + 1
+      } else if lcs[i+1][j] >= lcs[i][j+1] {
+        lcs[i][j] = lcs[i+1][j]
+      } else {
+        lcs[i][j] = lcs[i][j+1]
+      }
+    }
+  }
+  lines := make([]string, 0)
+  i, j := 0, 0
+  for i < len(x) || j < len(y) {
+    switch {
+    case i < len(x) && j < len(y) && x[i] == y[j]:
+      i++
+      j++
+    case j < len(y) && (i == len(x) || lcs[i][j+1] > lcs[i+1][j]):
+      lines = append(lines, "+ " + y[j])
+      j++
+    default:
+      lines = append(lines, "- " + x[i])
+      i++
+    }
+  }
+  return lines
+}
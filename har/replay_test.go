@@ -0,0 +1,105 @@
+package har
+
+import (
+  "bytes"
+  "compress/gzip"
+  "fmt"
+  "net/http"
+  "net/http/httptest"
+  "net/url"
+  "path/filepath"
+  "reflect"
+  "testing"
+  "time"
+)
+
+func TestDiffLines(t *testing.T) {
+  got := DiffLines("a\nb\nc\nd", "a\nc\nx\nd")
+  want := []string{"- b", "+ x"}
+  if !reflect.DeepEqual(got, want) {
+    t.Errorf("DiffLines = %q, want %q", got, want)
+  }
+}
+
+func TestReplay(t *testing.T) {
+  body := "<p>hello</p>"
+  app := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+    w.Header().Set("Content-Type", "text/html")
+    w.Header().Set("X-Request-Id", "new")
+    if r.URL.Query().Get("v") != "1" || r.Header.Get("Cookie") != "session=abc" {
+      w.WriteHeader(http.StatusBadRequest)
+    }
+    w.Write([]byte(body))
+  }))
+  defer app.Close()
+  base, _ := url.Parse(app.URL)
+
+  reqHeader := http.Header{"Cookie": {"session=abc"}}
+  respHeader := http.Header{"Content-Type": {"text/html"}, "X-Request-Id": {"old"}}
+  e := NewEntry(time.Now(), time.Millisecond, "GET", "http://localhost:5050/posts?v=1", reqHeader, nil, 200, respHeader, []byte(body))
+
+  if r := Replay(Client(), e, base, []string{"Date", "x-request-id"}); !r.OK() {
+    t.Errorf("replaying an unchanged response: %v %q", r.Err, r.Diffs)
+  }
+
+  body = "<p>goodbye</p>"
+  r := Replay(Client(), e, base, []string{"Date"})
+  want := []string{
+    `header X-Request-Id: "old" -> "new"`,
+    "body: 12 bytes -> 14 bytes",
+    "- <p>hello</p>",
+    "+ <p>goodbye</p>",
+  }
+  if r.Err != nil || !reflect.DeepEqual(r.Diffs, want) {
+    t.Errorf("Replay diffs = %q (%v), want %q", r.Diffs, r.Err, want)
+  }
+}
+
+func TestReplayDecodesAndSkipsTruncatedBodies(t *testing.T) {
+  body := "<p>hello</p>"
+  app := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+    w.Header().Set("Content-Type", "text/html")
+    w.Write([]byte(body))
+  }))
+  defer app.Close()
+  base, _ := url.Parse(app.URL)
+
+  var gz bytes.Buffer
+  zw := gzip.NewWriter(&gz)
+  zw.Write([]byte(body))
+  zw.Close()
+  respHeader := http.Header{"Content-Type": {"text/html"}, "Content-Encoding": {"gzip"}}
+  e := NewEntry(time.Now(), time.Millisecond, "GET", "http://localhost:5050/", http.Header{"Accept-Encoding": {"gzip"}}, nil, 200, respHeader, gz.Bytes())
+  if r := Replay(Client(), e, base, []string{"Date"}); !r.OK() {
+    t.Errorf("replaying a gzipped response: %v %q", r.Err, r.Diffs)
+  }
+
+  e = NewEntry(time.Now(), time.Millisecond, "GET", "http://localhost:5050/", nil, nil, 200, http.Header{"Content-Type": {"text/html"}}, []byte("<p>hel"))
+  if r := Replay(Client(), e, base, []string{"Date"}); r.OK() {
+    t.Errorf("replaying a cut body didn't fail")
+  }
+  e.Response.Content.Truncated = true
+  if r := Replay(Client(), e, base, []string{"Date"}); !r.OK() {
+    t.Errorf("replaying a truncated body: %v %q", r.Err, r.Diffs)
+  }
+}
+
+func TestRecorder(t *testing.T) {
+  filename := filepath.Join(t.TempDir(), "session.har")
+  r := NewRecorder(filename)
+  defer r.Close()
+  for i := 1; i <= 3; i++ {
+    e := NewEntry(time.Now(), time.Millisecond, "GET", fmt.Sprintf("http://localhost:5050/posts/%v", i), nil, nil, 200, http.Header{}, []byte("ok"))
+    if err := r.Add(e); err != nil {
+      t.Fatal(err)
+    }
+    // The file is complete after each entry.
+    f, err := Read(filename)
+    if err != nil {
+      t.Fatalf("reading after %v entries: %v", i, err)
+    }
+    if len(f.Log.Entries) != i || f.Log.Version != "1.2" || f.Log.Entries[i-1].Request.URL != e.Request.URL {
+      t.Errorf("after %v entries, read %+v", i, f.Log)
+    }
+  }
+}
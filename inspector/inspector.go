@@ -7,15 +7,31 @@ import (
   "strings"
   "io/ioutil"
   "path"
-  "log"
+  "os"
   "regexp"
+  "sort"
+  "strconv"
   "go/ast"
   "go/parser"
   "go/token"
+  "go/types"
+  "github.com/murz/eg/logger"
 )
 
+var buildLog = logger.New("build")
+
 type App struct {
+  Module string
   Actions []*Action
+  Helpers []*Helper
+  ErrorPages []*ErrorPage
+  Models []*Export
+  // Structs are the exported struct types of app/controllers other than the
+  // controllers, which actions can take as parameters.
+  Structs []*Export
+  // Routes are the registrations of conf/routes.go, checked against the
+  // actions by CheckRoutes.
+  Routes []*Route
 }
 
 type Action struct {
@@ -25,6 +41,36 @@ type Action struct {
   Fields []Field
 }
 
+// Helper is a function views can call. Exported functions of the helpers
+// package are registered under their own name; entries of its Funcs map have
+// no Func, the map is registered as is.
+type Helper struct {
+  Name string
+  Func string
+}
+
+// ErrorPage is a view in app/views/errors named after the status it's shown
+// for, like errors/404. Its Format is "html", or "json" for a text/template
+// answering API clients.
+type ErrorPage struct {
+  Status int
+  View string
+  Format string
+}
+
+// Export is an exported declaration of the models package: a "type",
+// "func", "var" or "const". Generic ones are left out, as they can't be used
+// without instantiating them.
+type Export struct {
+  Name string
+  Kind string
+  // Fields are the named fields of a struct type, with their tags.
+  Fields []Field `json:",omitempty"`
+  // Database is the connection a type is stored in, from a method returning
+  // its name, `func (Event) Database() string { return "analytics" }`.
+  Database string `json:",omitempty"`
+}
+
 type ContextKey struct {
   Value string
 }
@@ -32,36 +78,345 @@ type ContextKey struct {
 type Field struct {
   Key string
   Value string
+  Tag string `json:",omitempty"`
 }
 
 var app = &App{}
 
+// GetApp returns the model built by the last call to Inspect.
+func GetApp() *App {
+  return app
+}
+
 func GetActions() []*Action {
   return app.Actions
 }
 
 func InitActions() {
   app.Actions = make([]*Action, 0)
+  app.Helpers = nil
+  app.ErrorPages = nil
+  app.Models = nil
+  app.Structs = nil
+  app.Routes = nil
+}
+
+// GetModels returns the exported declarations of app/models, by name.
+func GetModels() []*Export {
+  return app.Models
+}
+
+// GetStruct returns the struct type an action parameter refers to, such as
+// models.Post or controllers.Filter, or nil if it isn't one of the app's.
+func GetStruct(typ string) *Export {
+  pkg, name := "controllers", typ
+  if i := strings.Index(typ, "."); i >= 0 {
+    pkg, name = typ[:i], typ[i + 1:]
+  }
+  exports := app.Structs
+  if pkg == "models" {
+    exports = app.Models
+  } else if pkg != "controllers" {
+    return nil
+  }
+  for _, e := range exports {
+    if e.Name == name && e.Kind == "type" && e.Fields != nil {
+      return e
+    }
+  }
+  return nil
+}
+
+// GetHelpers returns the view functions found in app/helpers.
+func GetHelpers() []*Helper {
+  return app.Helpers
+}
+
+// GetErrorPages returns the error pages found in app/views/errors, ordered by
+// status.
+func GetErrorPages() []*ErrorPage {
+  return app.ErrorPages
+}
+
+// HelperNames returns the names views can call the helpers by.
+func HelperNames() []string {
+  names := make([]string, 0)
+  for _, h := range app.Helpers {
+    names = append(names, h.Name)
+  }
+  return names
+}
+
+// GetModule returns the import path prefix of the app's packages, as found by
+// the last call to Inspect.
+func GetModule() string {
+  return app.Module
+}
+
+var moduleRegexp = regexp.MustCompile(`(?m)^module\s+"?([^"\s]+)"?`)
+
+// ReadModule returns the module path declared in dir/go.mod.
+func ReadModule(dir string) (string, error) {
+  txt, err := ioutil.ReadFile(path.Join(dir, "go.mod"))
+  if err != nil {
+    return "", err
+  }
+  m := moduleRegexp.FindSubmatch(txt)
+  if m == nil {
+    return "", fmt.Errorf("%s has no module directive", path.Join(dir, "go.mod"))
+  }
+  return string(m[1]), nil
+}
+
+// inspectModule finds the app's import path prefix. Apps without a go.mod
+// are assumed to live under GOPATH, named after their directory.
+func inspectModule() {
+  wd, _ := os.Getwd()
+  module, err := ReadModule(wd)
+  if err != nil {
+    dirs := strings.Split(wd, "/")
+    module = dirs[len(dirs) - 1]
+  }
+  app.Module = module
 }
 
 func Inspect() {
+  inspectModule()
   dirname := "app/controllers"
   dirlist, err := ioutil.ReadDir(dirname)
   if err != nil {
-    log.Fatalf("Error reading %s: %s\n", dirname, err)
+    buildLog.Fatalf("Error reading %s: %s", dirname, err)
   }
   for _, f := range dirlist {
     filename := path.Join(dirname, f.Name())
     inspectFile(filename)
   }
+  inspectHelpers("app/helpers")
+  inspectErrorPages("app/views/errors")
+  inspectModels("app/models")
+  inspectRoutes(RoutesFile)
+}
+
+// structFields returns the named fields of a struct, with their types as
+// written in the source.
+func structFields(st *ast.StructType) []Field {
+  fields := make([]Field, 0)
+  for _, f := range st.Fields.List {
+    tag := ""
+    if f.Tag != nil {
+      tag, _ = strconv.Unquote(f.Tag.Value)
+    }
+    for _, name := range f.Names {
+      fields = append(fields, Field{
+        Key: name.Name,
+        Value: types.ExprString(f.Type),
+        Tag: tag,
+      })
+    }
+  }
+  return fields
+}
+
+// inspectModels finds the exported declarations of the models package.
+func inspectModels(dirname string) {
+  fset := token.NewFileSet()
+  pkgs, err := parser.ParseDir(fset, dirname, func(fi os.FileInfo) bool {
+    return !strings.HasSuffix(fi.Name(), "_test.go")
+  }, 0)
+  if err != nil {
+    if !os.IsNotExist(err) {
+      panic(err)
+    }
+    return
+  }
+  app.Models = make([]*Export, 0)
+  databases := make(map[string]string)
+  add := func(name *ast.Ident, kind string) {
+    if name.IsExported() {
+      app.Models = append(app.Models, &Export{Name: name.Name, Kind: kind})
+    }
+  }
+  for _, pkg := range pkgs {
+    for _, f := range pkg.Files {
+      for _, decl := range f.Decls {
+        switch x := decl.(type) {
+        case *ast.FuncDecl:
+          if x.Recv == nil && x.Type.TypeParams == nil {
+            add(x.Name, "func")
+          } else if recv, name, ok := databaseMethod(x); ok {
+            databases[recv] = name
+          }
+        case *ast.GenDecl:
+          for _, spec := range x.Specs {
+            switch s := spec.(type) {
+            case *ast.TypeSpec:
+              if s.TypeParams == nil {
+                add(s.Name, "type")
+                if st, ok := s.Type.(*ast.StructType); ok && s.Name.IsExported() {
+                  app.Models[len(app.Models) - 1].Fields = structFields(st)
+                }
+              }
+            case *ast.ValueSpec:
+              for _, name := range s.Names {
+                add(name, strings.ToLower(x.Tok.String()))
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+  for _, m := range app.Models {
+    if m.Kind == "type" {
+      m.Database = databases[m.Name]
+    }
+  }
+  sort.Slice(app.Models, func(i, j int) bool {
+    return app.Models[i].Name < app.Models[j].Name
+  })
+  buildLog.Debugf("found %v models", len(app.Models))
+}
+
+// databaseMethod returns the receiver type of a Database() string method and
+// the name it returns, if it returns a constant string.
+func databaseMethod(fn *ast.FuncDecl) (string, string, bool) {
+  if fn.Recv == nil || fn.Name.Name != "Database" || len(fn.Recv.List) != 1 || fn.Body == nil ||
+    fn.Type.Params.NumFields() != 0 || fn.Type.Results.NumFields() != 1 || len(fn.Body.List) != 1 {
+    return "", "", false
+  }
+  recv := fn.Recv.List[0].Type
+  if star, ok := recv.(*ast.StarExpr); ok {
+    recv = star.X
+  }
+  ident, ok := recv.(*ast.Ident)
+  if !ok {
+    return "", "", false
+  }
+  ret, ok := fn.Body.List[0].(*ast.ReturnStmt)
+  if !ok || len(ret.Results) != 1 {
+    return "", "", false
+  }
+  lit, ok := ret.Results[0].(*ast.BasicLit)
+  if !ok || lit.Kind != token.STRING {
+    return "", "", false
+  }
+  name, err := strconv.Unquote(lit.Value)
+  return ident.Name, name, err == nil
+}
+
+var errorPageRegexp = regexp.MustCompile(`^([1-5][0-9][0-9])\.(html|json)$`)
+
+// inspectErrorPages finds the html and JSON views in dirname named after a
+// status code.
+func inspectErrorPages(dirname string) {
+  dirlist, err := ioutil.ReadDir(dirname)
+  if err != nil {
+    return
+  }
+  app.ErrorPages = make([]*ErrorPage, 0)
+  for _, f := range dirlist {
+    m := errorPageRegexp.FindStringSubmatch(f.Name())
+    if m == nil || f.IsDir() {
+      continue
+    }
+    status, _ := strconv.Atoi(m[1])
+    app.ErrorPages = append(app.ErrorPages, &ErrorPage{
+      Status: status,
+      View: "errors/" + m[1],
+      Format: m[2],
+    })
+  }
+  buildLog.Debugf("found %v error pages", len(app.ErrorPages))
+}
+
+// inspectHelpers finds the exported functions of the helpers package that
+// can be used in a template, and the keys of the Funcs map it may declare.
+func inspectHelpers(dirname string) {
+  fset := token.NewFileSet()
+  pkgs, err := parser.ParseDir(fset, dirname, func(fi os.FileInfo) bool {
+    return !strings.HasSuffix(fi.Name(), "_test.go")
+  }, 0)
+  if err != nil {
+    if !os.IsNotExist(err) {
+      panic(err)
+    }
+    return
+  }
+  app.Helpers = make([]*Helper, 0)
+  for _, pkg := range pkgs {
+    filenames := make([]string, 0)
+    for filename := range pkg.Files {
+      filenames = append(filenames, filename)
+    }
+    sort.Strings(filenames)
+    for _, filename := range filenames {
+      for _, decl := range pkg.Files[filename].Decls {
+        switch x := decl.(type) {
+        case *ast.FuncDecl:
+          if x.Recv == nil && x.Name.IsExported() && isTemplateFunc(x.Type) {
+            app.Helpers = append(app.Helpers, &Helper{Name: x.Name.Name, Func: x.Name.Name})
+          }
+        case *ast.GenDecl:
+          for _, spec := range x.Specs {
+            if v, ok := spec.(*ast.ValueSpec); ok {
+              inspectFuncMap(v)
+            }
+          }
+        }
+      }
+    }
+  }
+  buildLog.Debugf("found helpers %v", HelperNames())
+}
+
+// isTemplateFunc reports whether a function can be called from a template,
+// which needs it to return one value, or a value and an error.
+func isTemplateFunc(t *ast.FuncType) bool {
+  if t.Results == nil {
+    return false
+  }
+  n := 0
+  for _, field := range t.Results.List {
+    if len(field.Names) == 0 {
+      n++
+    } else {
+      n += len(field.Names)
+    }
+  }
+  if n == 2 {
+    last := t.Results.List[len(t.Results.List) - 1].Type
+    ident, ok := last.(*ast.Ident)
+    return ok && ident.Name == "error"
+  }
+  return n == 1
+}
+
+// inspectFuncMap adds the keys of a Funcs map literal.
+func inspectFuncMap(spec *ast.ValueSpec) {
+  for i, name := range spec.Names {
+    if name.Name != "Funcs" || i >= len(spec.Values) {
+      continue
+    }
+    lit, ok := spec.Values[i].(*ast.CompositeLit)
+    if !ok {
+      continue
+    }
+    for _, elt := range lit.Elts {
+      kv, ok := elt.(*ast.KeyValueExpr)
+      if !ok {
+        continue
+      }
+      if key, ok := kv.Key.(*ast.BasicLit); ok && key.Kind == token.STRING {
+        app.Helpers = append(app.Helpers, &Helper{Name: strings.Trim(key.Value, "\"`")})
+      }
+    }
+  }
 }
 
 func inspectFile(filename string) {
-  ctrlName := getCtrlName(filename)
-  if ctrlName == "" {
+  if !strings.HasSuffix(filename, ".go") || strings.HasSuffix(filename, "_test.go") {
     return
   }
-  
   fset := token.NewFileSet() // positions are relative to fset
 
   // Parse the file
@@ -69,6 +424,12 @@ func inspectFile(filename string) {
   if err != nil {
     panic(err)
   }
+  inspectStructs(f)
+
+  ctrlName := getCtrlName(filename)
+  if ctrlName == "" {
+    return
+  }
 
   txt, _ := ioutil.ReadFile(filename)
 
@@ -86,20 +447,19 @@ func inspectFile(filename string) {
           a.Fields = make([]Field, 0)
           if len(x.Type.Params.List) > 0 {
             for _, obj := range x.Type.Params.List {
-              a.Fields = append(a.Fields, Field{
-                Key: fmt.Sprintf("%v", obj.Names[0]),
-                Value: fmt.Sprintf("%v", obj.Type),
-              })
+              for _, name := range obj.Names {
+                a.Fields = append(a.Fields, Field{
+                  Key: name.Name,
+                  Value: types.ExprString(obj.Type),
+                })
+              }
             }
           }
 
-          log.Printf("FIELD!: %v", a.Fields)
-
           rx, _ := regexp.Compile(fmt.Sprintf("%s[\\w\\W]*?http\\.Context{([a-zA-Z0-9, ]+)}", x.Name.Name))
           wrx, _ := regexp.Compile(fmt.Sprintf("%s[\\w\\W]*?http\\.Context{([a-zA-Z0-9, \\W]+?)}", x.Name.Name))
           ctxs := rx.FindAllStringSubmatch(string(txt), -1)
           if len(ctxs) > 0 {
-            log.Printf("first worked!!")
             if len(ctxs[0]) > 1 {
               str := strings.Split(ctxs[0][1], ",")
               a.ContextKeys = make([]ContextKey, 0)
@@ -117,7 +477,6 @@ func inspectFile(filename string) {
               if len(wctxs[0]) > 1 {
                 str := strings.Split(wctxs[0][1], ",\n")
                 str = str[0:len(str) - 1]
-                log.Printf("SECOND WORKED!!!! %v", str)
                 a.ContextKeys = make([]ContextKey, 0)
                 for _, val := range str {
                   a.ContextKeys = append(a.ContextKeys, ContextKey{
@@ -128,7 +487,7 @@ func inspectFile(filename string) {
             }
           }
 
-          log.Printf("CONTEXT KEYS: %v", a)
+          buildLog.Debugf("found %v.%v(%v) with context %v", a.Controller, a.Name, a.Fields, a.ContextKeys)
           app.Actions = append(app.Actions, a)
         }
       }
@@ -137,6 +496,25 @@ func inspectFile(filename string) {
   })
 }
 
+// inspectStructs adds the exported struct types of a controllers file, other
+// than the controllers, to app.Structs.
+func inspectStructs(f *ast.File) {
+  for _, decl := range f.Decls {
+    gen, ok := decl.(*ast.GenDecl)
+    if !ok || gen.Tok != token.TYPE {
+      continue
+    }
+    for _, spec := range gen.Specs {
+      ts := spec.(*ast.TypeSpec)
+      st, ok := ts.Type.(*ast.StructType)
+      if !ok || !ts.Name.IsExported() || ts.TypeParams != nil || strings.HasSuffix(ts.Name.Name, "Controller") {
+        continue
+      }
+      app.Structs = append(app.Structs, &Export{Name: ts.Name.Name, Kind: "type", Fields: structFields(st)})
+    }
+  }
+}
+
 func getCtrlName(filename string) string {
   pieces := strings.Split(filename, "/")
   file := pieces[len(pieces)-1]
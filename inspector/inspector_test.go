@@ -0,0 +1,118 @@
+package inspector
+
+import (
+  "encoding/json"
+  "flag"
+  "io/ioutil"
+  "os"
+  "path/filepath"
+  "strings"
+  "testing"
+)
+
+var update = flag.Bool("update", false, "update the expected models in testdata")
+
+func TestGetCtrlName(t *testing.T) {
+  tests := map[string]string{
+    "app/controllers/posts_controller.go": "PostsController",
+    "app/controllers/blog_post_controller.go": "BlogPostController",
+    "users.go": "Users",
+  }
+  for filename, want := range tests {
+    if got := getCtrlName(filename); got != want {
+      t.Errorf("getCtrlName(%q) = %q, want %q", filename, got, want)
+    }
+  }
+}
+
+// TestInspectFixtures inspects each app in testdata and compares the model
+// with the app's expected.json.
+func TestInspectFixtures(t *testing.T) {
+  dirlist, err := ioutil.ReadDir("testdata")
+  if err != nil {
+    t.Fatal(err)
+  }
+  for _, f := range dirlist {
+    if !f.IsDir() {
+      continue
+    }
+    t.Run(f.Name(), func(t *testing.T) {
+      dir, _ := filepath.Abs(filepath.Join("testdata", f.Name()))
+      wd, _ := os.Getwd()
+      if err := os.Chdir(dir); err != nil {
+        t.Fatal(err)
+      }
+      defer os.Chdir(wd)
+
+      InitActions()
+      Inspect()
+      got, err := json.MarshalIndent(app, "", "  ")
+      if err != nil {
+        t.Fatal(err)
+      }
+      got = append(got, '\n')
+
+      expected := filepath.Join(dir, "expected.json")
+      if *update {
+        if err := ioutil.WriteFile(expected, got, 0666); err != nil {
+          t.Fatal(err)
+        }
+        return
+      }
+      want, err := ioutil.ReadFile(expected)
+      if err != nil {
+        t.Fatalf("%v (run the tests with -update to create it)", err)
+      }
+      if string(got) != string(want) {
+        t.Errorf("model doesn't match %v\ngot:\n%s\nwant:\n%s", expected, got, want)
+      }
+    })
+  }
+}
+
+func TestRouteErrors(t *testing.T) {
+  wd, _ := os.Getwd()
+  if err := os.Chdir("testdata/routes"); err != nil {
+    t.Fatal(err)
+  }
+  defer os.Chdir(wd)
+
+  InitActions()
+  Inspect()
+  want := []string{
+    "conf/routes.go:8: GET /posts/:slug: already routed to PostsController.Show at line 7",
+    "conf/routes.go:8: GET /posts/:slug: PostsController.Show has no parameter slug",
+    "conf/routes.go:9: POST /posts: PostsController has no action Create",
+    "conf/routes.go:10: GET /users/:id: unknown action UsersController.Show",
+    "conf/routes.go:11: DELETE /posts/:id/comments/:comment: PostsController.Show has no parameter comment",
+    "conf/routes.go:12: ANY /files/*path: PostsController.Index has no parameter path",
+    "conf/routes.go:13: GET /files/*name: already routed to PostsController.Index at line 12",
+    "conf/routes.go:13: GET /files/*name: PostsController.Index has no parameter name",
+  }
+  errs := routeErrors()
+  if len(errs) != len(want) {
+    t.Fatalf("got %v errors, want %v: %v", len(errs), len(want), errs)
+  }
+  for i, err := range errs {
+    if err.Error() != want[i] {
+      t.Errorf("error %v = %q, want %q", i, err, want[i])
+    }
+  }
+  if err := CheckRoutes(); err == nil || err.Error() != want[0] {
+    t.Errorf("CheckRoutes() = %v, want the first error", err)
+  }
+}
+
+func TestPathParams(t *testing.T) {
+  tests := map[string]string{
+    "/posts": "",
+    "/posts/:id": "id",
+    "/posts/{post}/comments/:id": "post,id",
+    "/files/*path": "path",
+  }
+  for p, want := range tests {
+    if got := strings.Join(pathParams(p), ","); got != want {
+      t.Errorf("pathParams(%q) = %q, want %q", p, got, want)
+    }
+  }
+}
@@ -0,0 +1,273 @@
+package inspector
+
+import (
+  "fmt"
+  "go/ast"
+  "go/parser"
+  "go/token"
+  "go/types"
+  "os"
+  "reflect"
+  "regexp"
+  "strconv"
+  "strings"
+  "github.com/murz/eg/params"
+)
+
+// RoutesFile is where the app registers its routes.
+const RoutesFile = "conf/routes.go"
+
+// Route is a registration of conf/routes.go, and where it's made. An empty
+// Method matches any.
+type Route struct {
+  Method string
+  Path string
+  Action string
+  File string
+  Line int
+}
+
+// httpMethods are the methods a route can be registered for.
+var httpMethods = map[string]bool{
+  "GET": true,
+  "POST": true,
+  "PUT": true,
+  "PATCH": true,
+  "DELETE": true,
+  "HEAD": true,
+  "OPTIONS": true,
+}
+
+// actionName matches an action written as a string, like
+// "PostsController.Show".
+var actionName = regexp.MustCompile(`^[A-Za-z_]\w*\.[A-Za-z_]\w*$`)
+
+// RouteError is a route that can't work, at its place in the routes file.
+type RouteError struct {
+  File string
+  Line int
+  Message string
+}
+
+func (e *RouteError) Error() string {
+  return fmt.Sprintf("%v:%v: %v", e.File, e.Line, e.Message)
+}
+
+// GetRoutes returns the routes found in conf/routes.go, in the order they're
+// registered.
+func GetRoutes() []*Route {
+  return app.Routes
+}
+
+// inspectRoutes finds the routes registered in filename. How the app hands
+// them to ego is up to it, so any call taking a path starting with "/" and an
+// action, written "PostsController.Show" or as a method expression of the
+// controllers package, is a route. Its method comes from the function called,
+// like Get, or from an argument, like "POST" or http.MethodPost; without one
+// it matches any. Registrations whose path or action aren't written out can't
+// be checked and are left out.
+func inspectRoutes(filename string) {
+  app.Routes = nil
+  if _, err := os.Stat(filename); err != nil {
+    return
+  }
+  app.Routes = make([]*Route, 0)
+  fset := token.NewFileSet()
+  f, err := parser.ParseFile(fset, filename, nil, 0)
+  if err != nil {
+    panic(err)
+  }
+  pkg := "controllers"
+  for _, imp := range f.Imports {
+    if p, _ := strconv.Unquote(imp.Path.Value); strings.HasSuffix(p, "/app/controllers") && imp.Name != nil {
+      pkg = imp.Name.Name
+    }
+  }
+  ast.Inspect(f, func(n ast.Node) bool {
+    call, ok := n.(*ast.CallExpr)
+    if !ok {
+      return true
+    }
+    r := &Route{
+      Method: routeMethod(call.Fun),
+      File: filename,
+      Line: fset.Position(call.Pos()).Line,
+    }
+    for _, arg := range call.Args {
+      if s, ok := stringLit(arg); ok && strings.HasPrefix(s, "/") && r.Path == "" {
+        r.Path = s
+      } else if action, ok := routeAction(arg, pkg); ok && r.Action == "" {
+        r.Action = action
+      } else if m := routeMethod(arg); m != "" && r.Method == "" {
+        r.Method = m
+      }
+    }
+    if r.Path == "" || r.Action == "" {
+      return true
+    }
+    buildLog.Debugf("found route %v %v to %v", methodName(r.Method), r.Path, r.Action)
+    app.Routes = append(app.Routes, r)
+    return true
+  })
+}
+
+func stringLit(e ast.Expr) (string, bool) {
+  lit, ok := e.(*ast.BasicLit)
+  if !ok || lit.Kind != token.STRING {
+    return "", false
+  }
+  s, err := strconv.Unquote(lit.Value)
+  return s, err == nil
+}
+
+// routeMethod returns the HTTP method an expression names, if any: a function
+// like Get or r.Post, a string like "post" or a constant like http.MethodPost.
+func routeMethod(e ast.Expr) string {
+  name := ""
+  switch e := e.(type) {
+  case *ast.Ident:
+    name = e.Name
+  case *ast.SelectorExpr:
+    name = strings.TrimPrefix(e.Sel.Name, "Method")
+  case *ast.BasicLit:
+    name, _ = stringLit(e)
+  }
+  if m := strings.ToUpper(name); httpMethods[m] {
+    return m
+  }
+  return ""
+}
+
+// methodName is how a route's method is shown.
+func methodName(method string) string {
+  if method == "" {
+    return "ANY"
+  }
+  return method
+}
+
+// routeAction returns the action a route names, either as a string like
+// "PostsController.Show" or as a method expression of pkg, the controllers
+// package, like controllers.PostsController.Show or
+// (*controllers.PostsController).Show.
+func routeAction(e ast.Expr, pkg string) (string, bool) {
+  if s, ok := stringLit(e); ok {
+    return s, actionName.MatchString(s)
+  }
+  if _, ok := e.(*ast.SelectorExpr); !ok {
+    return "", false
+  }
+  name := strings.NewReplacer("(", "", ")", "", "*", "").Replace(types.ExprString(e))
+  if !strings.HasPrefix(name, pkg + ".") || strings.Count(name, ".") != 2 {
+    return "", false
+  }
+  return strings.TrimPrefix(name, pkg + "."), true
+}
+
+// pathParams returns the names of the parameters of a route path, written
+// :name, {name} or *name for the rest of the path.
+func pathParams(p string) []string {
+  names := make([]string, 0)
+  for _, seg := range strings.Split(p, "/") {
+    switch {
+    case strings.HasPrefix(seg, ":") || strings.HasPrefix(seg, "*"):
+      names = append(names, seg[1:])
+    case strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}"):
+      names = append(names, seg[1:len(seg) - 1])
+    }
+  }
+  return names
+}
+
+// routeKey is what two routes matching the same requests have in common: the
+// method and the path with its parameters unnamed.
+func routeKey(r *Route) string {
+  segs := strings.Split(r.Path, "/")
+  for i, seg := range segs {
+    switch {
+    case strings.HasPrefix(seg, ":") || (strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}")):
+      segs[i] = ":"
+    case strings.HasPrefix(seg, "*"):
+      segs[i] = "*"
+    }
+  }
+  return r.Method + " " + strings.TrimSuffix(strings.Join(segs, "/"), "/")
+}
+
+// actionParams returns the names the path parameters of an action can bind
+// to: its own parameters, and the fields of those that are structs.
+func actionParams(a *Action) map[string]bool {
+  names := make(map[string]bool)
+  for _, f := range a.Fields {
+    names[f.Key] = true
+    s := GetStruct(strings.TrimLeft(f.Value, "*"))
+    if s == nil {
+      continue
+    }
+    for _, sf := range s.Fields {
+      if key, _ := params.Key(sf.Key, reflect.StructTag(sf.Tag)); key != "" && ast.IsExported(sf.Key) {
+        names[key] = true
+      }
+    }
+  }
+  return names
+}
+
+// routeErrors cross-checks the routes with the actions: each must route to an
+// action that exists, name only path parameters the action takes, and be the
+// only route for its method and path.
+func routeErrors() []*RouteError {
+  actions := make(map[string]*Action)
+  controllers := make(map[string]bool)
+  for _, a := range app.Actions {
+    actions[a.Controller + "." + a.Name] = a
+    controllers[a.Controller] = true
+  }
+  errs := make([]*RouteError, 0)
+  seen := make(map[string]*Route)
+  for _, r := range app.Routes {
+    fail := func(format string, args ...interface{}) {
+      errs = append(errs, &RouteError{
+        File: r.File,
+        Line: r.Line,
+        Message: fmt.Sprintf("%v %v: ", methodName(r.Method), r.Path) + fmt.Sprintf(format, args...),
+      })
+    }
+    // A route for any method comes first for each method too.
+    first, ok := seen[routeKey(r)]
+    if all, found := seen[routeKey(&Route{Path: r.Path})]; found && !ok {
+      first, ok = all, true
+    }
+    if ok {
+      fail("already routed to %v at line %v", first.Action, first.Line)
+    } else {
+      seen[routeKey(r)] = r
+    }
+    a, ok := actions[r.Action]
+    if !ok {
+      ctrl := strings.Split(r.Action, ".")[0]
+      if controllers[ctrl] {
+        fail("%v has no action %v", ctrl, strings.TrimPrefix(r.Action, ctrl + "."))
+      } else {
+        fail("unknown action %v", r.Action)
+      }
+      continue
+    }
+    names := actionParams(a)
+    for _, name := range pathParams(r.Path) {
+      if !names[name] {
+        fail("%v has no parameter %v", r.Action, name)
+      }
+    }
+  }
+  return errs
+}
+
+// CheckRoutes makes sure the routes of the last call to Inspect can work. It
+// returns the first error, with the file and line of the route.
+func CheckRoutes() error {
+  if errs := routeErrors(); len(errs) > 0 {
+    return errs[0]
+  }
+  return nil
+}
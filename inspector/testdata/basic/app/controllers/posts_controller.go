@@ -0,0 +1,30 @@
+package controllers
+
+import (
+	"github.com/murz/ego/http"
+)
+
+type PostsController struct {
+	*http.Controller
+}
+
+func (c PostsController) Index() *http.Response {
+	return http.NotImplemented
+}
+
+func (c PostsController) Show(id int, format string) *http.Response {
+	return http.NotImplemented
+}
+
+func helper() string {
+	return "not an action"
+}
+
+type Filter struct {
+	Tag  string `json:"tag"`
+	Page int
+}
+
+func (c PostsController) Search(q string, page, per int, f *Filter) *http.Response {
+	return http.NotImplemented
+}
@@ -0,0 +1,26 @@
+package conf
+
+import (
+	nethttp "net/http"
+
+	"basic/app/controllers"
+)
+
+func Routes() {
+	route("GET", "/posts", "PostsController.Index")
+	route(nethttp.MethodGet, "/posts/{id}/{format}", "PostsController.Show")
+	get("/posts/search/:tag", controllers.PostsController.Search)
+	get(prefix+"/feed", "PostsController.Index")
+	handle("/posts/latest", "PostsController.Index")
+}
+
+const prefix = "/api"
+
+// route, get and handle stand in for the app's calls into ego's router.
+func route(method string, path string, action interface{}) {}
+
+func get(path string, action interface{}) {
+	route("GET", path, action)
+}
+
+func handle(path string, action interface{}) {}
@@ -0,0 +1,23 @@
+package controllers
+
+import (
+	"github.com/murz/ego/http"
+)
+
+type UsersController struct {
+	*http.Controller
+}
+
+func (c UsersController) Show(id int) *http.Response {
+	user := id
+	return c.Render(http.Context{user, id})
+}
+
+func (c UsersController) List() *http.Response {
+	users := 1
+	page := 2
+	return c.Render(http.Context{
+		users,
+		page,
+	})
+}
@@ -0,0 +1,35 @@
+package helpers
+
+import (
+	"fmt"
+	"html/template"
+	"strings"
+	"time"
+)
+
+var Funcs = template.FuncMap{
+	"upper": strings.ToUpper,
+	"date":  Date,
+}
+
+func Date(t time.Time) string {
+	return t.Format("Jan 2, 2006")
+}
+
+func Truncate(s string, n int) (string, error) {
+	if n < 0 {
+		return "", fmt.Errorf("can't truncate to %v", n)
+	}
+	if len(s) > n {
+		return s[:n], nil
+	}
+	return s, nil
+}
+
+// Log isn't a helper, it returns nothing.
+func Log(s string) {
+}
+
+func pad(s string) string {
+	return " " + s + " "
+}
@@ -0,0 +1,13 @@
+package controllers
+
+import (
+	"github.com/murz/ego/http"
+)
+
+type PagesController struct {
+	http.Controller
+}
+
+func (c PagesController) Home() *http.Response {
+	return http.NotImplemented
+}
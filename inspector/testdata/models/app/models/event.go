@@ -0,0 +1,11 @@
+package models
+
+// Event is stored in the analytics database.
+type Event struct {
+	ID   int
+	Name string
+}
+
+func (Event) Database() string {
+	return "analytics"
+}
@@ -0,0 +1,37 @@
+package models
+
+import "time"
+
+const MaxTitle = 80
+
+var DefaultAuthor = "anonymous"
+
+type Post struct {
+	ID        int
+	Title     string
+	CreatedAt time.Time `db:"created_at,index"`
+}
+
+type Store interface {
+	Find(id int) (*Post, error)
+}
+
+type Page[T any] struct {
+	Items []T
+}
+
+func FindPost(id int) (*Post, error) {
+	return &Post{ID: id}, nil
+}
+
+func (p *Post) Summary() string {
+	return p.Title
+}
+
+func Map[T any](items []T, f func(T) T) []T {
+	return items
+}
+
+func validate(p *Post) error {
+	return nil
+}
@@ -0,0 +1,17 @@
+package controllers
+
+import (
+	"github.com/murz/ego/http"
+)
+
+type PostsController struct {
+	*http.Controller
+}
+
+func (c PostsController) Index() *http.Response {
+	return http.NotImplemented
+}
+
+func (c PostsController) Show(id int) *http.Response {
+	return http.NotImplemented
+}
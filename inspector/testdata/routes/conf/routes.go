@@ -0,0 +1,22 @@
+package conf
+
+import "routes/app/controllers"
+
+func Routes() {
+	get("/posts", "PostsController.Index")
+	get("/posts/:id", "PostsController.Show")
+	route("get", "/posts/:slug", "PostsController.Show")
+	route("POST", "/posts", "PostsController.Create")
+	get("/users/:id", "UsersController.Show")
+	route("DELETE", "/posts/:id/comments/:comment", (*controllers.PostsController).Show)
+	handle("/files/*path", "PostsController.Index")
+	get("/files/*name", "PostsController.Index")
+}
+
+func route(method string, path string, action interface{}) {}
+
+func get(path string, action interface{}) {
+	route("GET", path, action)
+}
+
+func handle(path string, action interface{}) {}
@@ -0,0 +1,178 @@
+// Package logger provides eg's leveled logging. Each message comes from a
+// component (build, watch, app, proxy, ...) and is written as a colored,
+// timestamped line or, in JSON mode, as one JSON object per line.
+package logger
+
+import (
+  "bytes"
+  "encoding/json"
+  "fmt"
+  "io"
+  "os"
+  "strings"
+  "sync"
+  "time"
+)
+
+type Level int
+
+const (
+  Debug Level = iota
+  Info
+  Warn
+  Error
+)
+
+var levelNames = []string{"debug", "info", "warn", "error"}
+
+func (l Level) String() string {
+  return levelNames[l]
+}
+
+var (
+  lock sync.Mutex
+  out io.Writer = os.Stderr
+  level = Info
+  jsonMode = false
+  color = isTerminal(os.Stderr) && os.Getenv("NO_COLOR") == ""
+)
+
+// SetLevel hides messages below l.
+func SetLevel(l Level) {
+  lock.Lock()
+  defer lock.Unlock()
+  level = l
+}
+
+// SetJSON switches between text and JSON lines.
+func SetJSON(on bool) {
+  lock.Lock()
+  defer lock.Unlock()
+  jsonMode = on
+}
+
+// SetOutput sets where messages are written, without color.
+func SetOutput(w io.Writer) {
+  lock.Lock()
+  defer lock.Unlock()
+  out = w
+  color = false
+}
+
+func isTerminal(f *os.File) bool {
+  fi, err := f.Stat()
+  return err == nil && fi.Mode() & os.ModeCharDevice != 0
+}
+
+// colors are the ANSI colors of the component prefixes.
+var colors = map[string]string{
+  "build": "36",
+  "watch": "35",
+  "app": "32",
+  "proxy": "34",
+}
+
+var levelColors = map[Level]string{
+  Warn: "33",
+  Error: "31",
+}
+
+type Logger struct {
+  Component string
+}
+
+func New(component string) *Logger {
+  return &Logger{component}
+}
+
+func (l *Logger) Debugf(format string, args ...interface{}) {
+  l.log(Debug, "", fmt.Sprintf(format, args...))
+}
+
+func (l *Logger) Infof(format string, args ...interface{}) {
+  l.log(Info, "", fmt.Sprintf(format, args...))
+}
+
+func (l *Logger) Warnf(format string, args ...interface{}) {
+  l.log(Warn, "", fmt.Sprintf(format, args...))
+}
+
+func (l *Logger) Errorf(format string, args ...interface{}) {
+  l.log(Error, "", fmt.Sprintf(format, args...))
+}
+
+// Fatalf logs an error and exits.
+func (l *Logger) Fatalf(format string, args ...interface{}) {
+  l.Errorf(format, args...)
+  os.Exit(1)
+}
+
+func (l *Logger) log(lvl Level, stream string, msg string) {
+  lock.Lock()
+  defer lock.Unlock()
+  if lvl < level {
+    return
+  }
+  now := time.Now()
+  if jsonMode {
+    entry := map[string]string{
+      "time": now.Format(time.RFC3339Nano),
+      "level": lvl.String(),
+      "component": l.Component,
+      "msg": msg,
+    }
+    if stream != "" {
+      entry["stream"] = stream
+    }
+    data, _ := json.Marshal(entry)
+    out.Write(append(data, '\n'))
+    return
+  }
+  prefix := fmt.Sprintf("%-5v", l.Component)
+  if color {
+    if c, ok := colors[l.Component]; ok {
+      prefix = "\x1b[" + c + "m" + prefix + "\x1b[0m"
+    }
+  }
+  if stream != "" && stream != "stdout" {
+    msg = stream + ": " + msg
+  }
+  if lvl >= Warn {
+    tag := strings.ToUpper(lvl.String())
+    if color {
+      tag = "\x1b[" + levelColors[lvl] + "m" + tag + "\x1b[0m"
+    }
+    msg = tag + " " + msg
+  }
+  fmt.Fprintf(out, "%v %v %v\n", now.Format("15:04:05.000"), prefix, msg)
+}
+
+// Writer returns a writer that logs each line written to it, for a child
+// process's stdout or stderr. Lines of streams other than stdout are marked
+// with the stream's name.
+func (l *Logger) Writer(lvl Level, stream string) io.Writer {
+  return &lineWriter{l: l, level: lvl, stream: stream}
+}
+
+type lineWriter struct {
+  sync.Mutex
+  l *Logger
+  level Level
+  stream string
+  buf bytes.Buffer
+}
+
+func (w *lineWriter) Write(data []byte) (int, error) {
+  w.Lock()
+  defer w.Unlock()
+  w.buf.Write(data)
+  for {
+    i := bytes.IndexByte(w.buf.Bytes(), '\n')
+    if i < 0 {
+      break
+    }
+    line := string(w.buf.Next(i + 1))
+    w.l.log(w.level, w.stream, strings.TrimRight(line, "\r\n"))
+  }
+  return len(data), nil
+}
@@ -0,0 +1,79 @@
+package logger
+
+import (
+  "bytes"
+  "encoding/json"
+  "fmt"
+  "regexp"
+  "strings"
+  "testing"
+)
+
+func capture(t *testing.T) *bytes.Buffer {
+  var buf bytes.Buffer
+  SetOutput(&buf)
+  t.Cleanup(func() {
+    SetLevel(Info)
+    SetJSON(false)
+  })
+  return &buf
+}
+
+func TestLevels(t *testing.T) {
+  buf := capture(t)
+  l := New("build")
+  SetLevel(Warn)
+  l.Infof("hidden")
+  l.Warnf("slow %v", "build")
+  SetLevel(Debug)
+  l.Debugf("shown")
+
+  lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+  want := []string{"build WARN slow build", "build shown"}
+  if len(lines) != len(want) {
+    t.Fatalf("logged %q, want %q", lines, want)
+  }
+  stamp := regexp.MustCompile(`^\d\d:\d\d:\d\d\.\d{3} `)
+  for i, line := range lines {
+    if !stamp.MatchString(line) || stamp.ReplaceAllString(line, "") != want[i] {
+      t.Errorf("line %v = %q, want a timestamp and %q", i, line, want[i])
+    }
+  }
+}
+
+func TestJSONWriter(t *testing.T) {
+  buf := capture(t)
+  SetJSON(true)
+  w := New("app").Writer(Info, "stderr")
+  fmt.Fprint(w, "listening on :5000\npanic: ")
+  fmt.Fprint(w, "oops\n")
+
+  dec := json.NewDecoder(buf)
+  for _, want := range []string{"listening on :5000", "panic: oops"} {
+    var entry map[string]string
+    if err := dec.Decode(&entry); err != nil {
+      t.Fatal(err)
+    }
+    if entry["msg"] != want || entry["component"] != "app" || entry["stream"] != "stderr" || entry["level"] != "info" || entry["time"] == "" {
+      t.Errorf("logged %v, want %q from app's stderr", entry, want)
+    }
+  }
+  if dec.More() {
+    t.Errorf("logged more than two lines")
+  }
+}
+
+func TestStreamTag(t *testing.T) {
+  buf := capture(t)
+  l := New("app")
+  fmt.Fprintln(l.Writer(Info, "stdout"), "listening")
+  fmt.Fprintln(l.Writer(Warn, "stderr"), "panic: oops")
+  lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+  want := []string{"app   listening", "app   WARN stderr: panic: oops"}
+  for i, line := range lines {
+    if i >= len(want) || !strings.HasSuffix(line, want[i]) {
+      t.Errorf("logged %q, want lines ending in %q", lines, want)
+      break
+    }
+  }
+}
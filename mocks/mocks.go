@@ -0,0 +1,253 @@
+// Package mocks provides the stub routes and upstream rules that the dev proxy
+// serves in front of an ego app. Rules are read from conf/mocks/*.json and
+// *.yaml, e.g.
+//
+//   [
+//     {"method": "GET", "path": "/api/users/:id", "query": {"active": "true"},
+//      "latency": "200ms",
+//      "response": {"status": 200, "json": {"id": 1, "name": "Ann"}}},
+//     {"path": "/api/legacy/*", "proxy": "https://legacy.example.com"}
+//   ]
+package mocks
+
+import (
+  "encoding/json"
+  "fmt"
+  "io"
+  "io/ioutil"
+  "net/http"
+  "net/http/httputil"
+  "net/url"
+  "os"
+  "path"
+  "path/filepath"
+  "sort"
+  "strings"
+  "time"
+  "gopkg.in/yaml.v3"
+)
+
+// Dir is where the rules live, next to conf/routes.go.
+const Dir = "conf/mocks"
+
+// Rule matches requests and either answers them with a canned Response or
+// passes them on to the Proxy host.
+type Rule struct {
+  Name string `json:"name" yaml:"name"`
+  Method string `json:"method" yaml:"method"`
+  Path string `json:"path" yaml:"path"`
+  Query map[string]string `json:"query" yaml:"query"`
+  Headers map[string]string `json:"headers" yaml:"headers"`
+  Latency string `json:"latency" yaml:"latency"`
+  Response *Response `json:"response" yaml:"response"`
+  Proxy string `json:"proxy" yaml:"proxy"`
+  File string `json:"-" yaml:"-"`
+  latency time.Duration
+  upstream *httputil.ReverseProxy
+}
+
+type Response struct {
+  Status int `json:"status" yaml:"status"`
+  Headers map[string]string `json:"headers" yaml:"headers"`
+  Body string `json:"body" yaml:"body"`
+  JSON interface{} `json:"json" yaml:"json"`
+  // BodyFile is read relative to Dir.
+  BodyFile string `json:"body_file" yaml:"body_file"`
+}
+
+// IsFile reports whether filename holds rules.
+func IsFile(filename string) bool {
+  return filepath.Clean(filepath.Dir(filename)) == filepath.Clean(Dir) && isRules(filename)
+}
+
+func isRules(filename string) bool {
+  switch filepath.Ext(filename) {
+  case ".json", ".yaml", ".yml":
+    return true
+  }
+  return false
+}
+
+// Load reads the rules in dir, in file name order. A missing dir has no rules.
+func Load(dir string) ([]*Rule, error) {
+  files, err := ioutil.ReadDir(dir)
+  if os.IsNotExist(err) {
+    return []*Rule{}, nil
+  } else if err != nil {
+    return nil, err
+  }
+  names := make([]string, 0)
+  for _, f := range files {
+    if !f.IsDir() && isRules(f.Name()) {
+      names = append(names, f.Name())
+    }
+  }
+  sort.Strings(names)
+  rules := make([]*Rule, 0)
+  for _, name := range names {
+    fileRules, err := LoadFile(filepath.Join(dir, name))
+    if err != nil {
+      return nil, err
+    }
+    rules = append(rules, fileRules...)
+  }
+  return rules, nil
+}
+
+// LoadFile reads the rules in a JSON or YAML file.
+func LoadFile(filename string) ([]*Rule, error) {
+  data, err := ioutil.ReadFile(filename)
+  if err != nil {
+    return nil, err
+  }
+  rules := make([]*Rule, 0)
+  if filepath.Ext(filename) == ".json" {
+    err = json.Unmarshal(data, &rules)
+  } else {
+    err = yaml.Unmarshal(data, &rules)
+  }
+  if err != nil {
+    return nil, fmt.Errorf("%v: %v", filename, err)
+  }
+  for i, r := range rules {
+    r.File = filename
+    if r.Name == "" {
+      r.Name = fmt.Sprintf("%v #%v", filepath.Base(filename), i + 1)
+    }
+    if err := r.prepare(filepath.Dir(filename)); err != nil {
+      return nil, fmt.Errorf("%v: %v: %v", filename, r.Name, err)
+    }
+  }
+  return rules, nil
+}
+
+func (r *Rule) prepare(dir string) error {
+  if r.Path == "" {
+    return fmt.Errorf("no path")
+  }
+  if (r.Response == nil) == (r.Proxy == "") {
+    return fmt.Errorf("needs either a response or a proxy")
+  }
+  if r.Latency != "" {
+    d, err := time.ParseDuration(r.Latency)
+    if err != nil {
+      return err
+    }
+    r.latency = d
+  }
+  if r.Response != nil && r.Response.BodyFile != "" {
+    data, err := ioutil.ReadFile(filepath.Join(dir, r.Response.BodyFile))
+    if err != nil {
+      return err
+    }
+    r.Response.Body = string(data)
+  }
+  if r.Proxy != "" {
+    u, err := url.Parse(r.Proxy)
+    if err != nil || u.Host == "" {
+      return fmt.Errorf("bad proxy url %q", r.Proxy)
+    }
+    r.upstream = httputil.NewSingleHostReverseProxy(u)
+    director := r.upstream.Director
+    r.upstream.Director = func(req *http.Request) {
+      director(req)
+      req.Host = u.Host
+    }
+  }
+  return nil
+}
+
+// Find returns the first rule that matches req, or nil.
+func Find(rules []*Rule, req *http.Request) *Rule {
+  for _, r := range rules {
+    if r.Matches(req) {
+      return r
+    }
+  }
+  return nil
+}
+
+// Matches reports whether the method, path, query and headers of req match
+// the rule. Values in the query and headers may be globs.
+func (r *Rule) Matches(req *http.Request) bool {
+  if r.Method != "" && !strings.EqualFold(r.Method, req.Method) {
+    return false
+  }
+  if !MatchPath(r.Path, req.URL.Path) {
+    return false
+  }
+  query := req.URL.Query()
+  for k, v := range r.Query {
+    if ok, _ := path.Match(v, query.Get(k)); !ok {
+      return false
+    }
+  }
+  for k, v := range r.Headers {
+    if ok, _ := path.Match(v, req.Header.Get(k)); !ok {
+      return false
+    }
+  }
+  return true
+}
+
+// MatchPath matches a URL path against a pattern. A :name segment matches any
+// one segment, a trailing * matches the rest of the path and other segments
+// may be globs.
+func MatchPath(pattern string, p string) bool {
+  want := strings.Split(strings.Trim(pattern, "/"), "/")
+  got := strings.Split(strings.Trim(p, "/"), "/")
+  for i, seg := range want {
+    if seg == "*" && i == len(want) - 1 {
+      return true
+    }
+    if i >= len(got) {
+      return false
+    }
+    if strings.HasPrefix(seg, ":") {
+      continue
+    }
+    if ok, _ := path.Match(seg, got[i]); !ok {
+      return false
+    }
+  }
+  return len(want) == len(got)
+}
+
+// Describe says what handled a request, for the request inspector.
+func (r *Rule) Describe() string {
+  if r.Proxy != "" {
+    return fmt.Sprintf("proxy %v (%v)", r.Proxy, r.Name)
+  }
+  return fmt.Sprintf("mock %v", r.Name)
+}
+
+// ServeHTTP answers req after the rule's latency.
+func (r *Rule) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+  if r.latency > 0 {
+    time.Sleep(r.latency)
+  }
+  if r.upstream != nil {
+    r.upstream.ServeHTTP(w, req)
+    return
+  }
+  resp := r.Response
+  body := resp.Body
+  if resp.JSON != nil {
+    data, err := json.MarshalIndent(resp.JSON, "", "  ")
+    if err != nil {
+      http.Error(w, err.Error(), http.StatusInternalServerError)
+      return
+    }
+    body = string(data)
+    w.Header().Set("Content-Type", "application/json")
+  }
+  for k, v := range resp.Headers {
+    w.Header().Set(k, v)
+  }
+  status := resp.Status
+  if status == 0 {
+    status = http.StatusOK
+  }
+  w.WriteHeader(status)
+  io.WriteString(w, body)
+}
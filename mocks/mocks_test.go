@@ -0,0 +1,87 @@
+package mocks
+
+import (
+  "io/ioutil"
+  "net/http"
+  "net/http/httptest"
+  "path/filepath"
+  "testing"
+)
+
+func TestMatchPath(t *testing.T) {
+  tests := []struct {
+    pattern string
+    path string
+    want bool
+  }{
+    {"/api/users/:id", "/api/users/7", true},
+    {"/api/users/:id", "/api/users", false},
+    {"/api/users/:id", "/api/users/7/posts", false},
+    {"/api/legacy/*", "/api/legacy/a/b", true},
+    {"/api/legacy/*", "/api/other/a", false},
+    {"/files/*.png", "/files/a.png", true},
+    {"/", "/", true},
+  }
+  for _, test := range tests {
+    if got := MatchPath(test.pattern, test.path); got != test.want {
+      t.Errorf("MatchPath(%q, %q) = %v, want %v", test.pattern, test.path, got, test.want)
+    }
+  }
+}
+
+func TestLoadAndServe(t *testing.T) {
+  upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+    w.Write([]byte("legacy " + r.URL.Path))
+  }))
+  defer upstream.Close()
+
+  dir := t.TempDir()
+  files := map[string]string{
+    "a_users.yaml": "- method: GET\n  path: /api/users/:id\n  query:\n    active: \"true\"\n  response:\n    status: 200\n    json:\n      name: Ann\n",
+    "b_legacy.json": `[{"name": "legacy", "path": "/api/legacy/*", "proxy": "` + upstream.URL + `"}]`,
+    "notes.txt": "not rules",
+  }
+  for name, content := range files {
+    if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0666); err != nil {
+      t.Fatal(err)
+    }
+  }
+  rules, err := Load(dir)
+  if err != nil {
+    t.Fatal(err)
+  }
+  if len(rules) != 2 {
+    t.Fatalf("loaded %v rules, want 2", len(rules))
+  }
+
+  tests := []struct {
+    method string
+    url string
+    rule string
+    body string
+  }{
+    {"GET", "/api/users/1?active=true", "a_users.yaml #1", "{\n  \"name\": \"Ann\"\n}"},
+    {"GET", "/api/users/1", "", ""},
+    {"POST", "/api/users/1?active=true", "", ""},
+    {"GET", "/api/legacy/reports/2", "legacy", "legacy /api/legacy/reports/2"},
+  }
+  for _, test := range tests {
+    req := httptest.NewRequest(test.method, test.url, nil)
+    rule := Find(rules, req)
+    if rule == nil {
+      if test.rule != "" {
+        t.Errorf("%v %v matched nothing, want %v", test.method, test.url, test.rule)
+      }
+      continue
+    }
+    if rule.Name != test.rule {
+      t.Errorf("%v %v matched %q, want %q", test.method, test.url, rule.Name, test.rule)
+      continue
+    }
+    w := httptest.NewRecorder()
+    rule.ServeHTTP(w, req)
+    if w.Body.String() != test.body {
+      t.Errorf("%v %v = %q, want %q", test.method, test.url, w.Body.String(), test.body)
+    }
+  }
+}
@@ -0,0 +1,83 @@
+package packs
+
+import (
+  "sort"
+)
+
+// appDirs are the directories every bundled pack starts with.
+var appDirs = []string{
+  "app",
+  "app/controllers",
+  "app/helpers",
+  "app/models",
+  "conf",
+  "public",
+}
+
+var confFiles = []File{
+  {Path: "go.mod", Template: "go.mod.mustache"},
+  {Path: "conf/app.json", Template: "app.json.mustache"},
+  {Path: "conf/routes.go", Template: "routes.go.mustache"},
+  {Path: "conf/db.go", Template: "db.go.mustache"},
+}
+
+// requireHooks add the modules the generated code imports to the app's go.mod.
+var requireHooks = [][]string{
+  {"go", "get", "github.com/murz/ego@{{EgoVersion}}", "github.com/murz/eg@{{EgVersion}}"},
+}
+
+var bundled = map[string]*Pack{
+  "html": &Pack{
+    Name: "html",
+    Description: "A server-rendered app with views and assets (the default)",
+    Dirs: append(append([]string{}, appDirs...),
+      "app/views",
+      "app/views/errors",
+      "app/assets",
+      "app/assets/javascripts",
+      "app/assets/stylesheets",
+      "app/assets/images",
+    ),
+    Files: append(append([]File{}, confFiles...),
+      File{Path: "app/views/errors/404.html", Template: "error.html.mustache", Vars: map[string]string{
+        "Message": "404 Not Found",
+      }},
+      File{Path: "app/views/errors/501.html", Template: "error.html.mustache", Vars: map[string]string{
+        "Message": "501 Not Implemented",
+      }},
+    ),
+    Hooks: requireHooks,
+  },
+  "api": &Pack{
+    Name: "api",
+    Description: "A JSON API without views or assets",
+    Dirs: append(append([]string{}, appDirs...), "app/views/errors"),
+    Files: append(append([]File{}, confFiles...),
+      // The JSON pages are templates of their own, rendered with the status.
+      File{Path: "app/views/errors/404.json", Template: "error.json.mustache"},
+      File{Path: "app/views/errors/501.json", Template: "error.json.mustache"},
+    ),
+    Hooks: requireHooks,
+  },
+  "minimal": &Pack{
+    Name: "minimal",
+    Description: "Just controllers and conf",
+    Dirs: []string{
+      "app",
+      "app/controllers",
+      "conf",
+    },
+    Files: confFiles,
+    Hooks: requireHooks,
+  },
+}
+
+// Names returns the names of the bundled packs, sorted.
+func Names() []string {
+  names := make([]string, 0, len(bundled))
+  for name := range bundled {
+    names = append(names, name)
+  }
+  sort.Strings(names)
+  return names
+}
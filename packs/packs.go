@@ -0,0 +1,289 @@
+// Package packs provides the starter kits used by `eg new app`. A pack lists
+// the directories and files of a new app, the variables used to render them,
+// and the commands to run once everything has been written.
+package packs
+
+import (
+  "archive/tar"
+  "archive/zip"
+  "bufio"
+  "compress/gzip"
+  "encoding/json"
+  "fmt"
+  "io"
+  "io/ioutil"
+  "os"
+  "os/exec"
+  "path"
+  "path/filepath"
+  "strings"
+  "github.com/hoisie/mustache"
+  "github.com/murz/eg/templates"
+)
+
+// Manifest is the name of the file describing a pack, found at the root of a
+// pack directory or archive.
+const Manifest = "pack.json"
+
+type Pack struct {
+  Name string `json:"name"`
+  Description string `json:"description"`
+  Dirs []string `json:"dirs"`
+  Files []File `json:"files"`
+  Variables []Variable `json:"variables"`
+  Hooks [][]string `json:"hooks"`
+
+  // open reads a file shipped with the pack, for File.Source.
+  open func(name string) ([]byte, error)
+}
+
+// File is a file written into the new app. Its contents come from either a
+// named eg template (looked up with templates.Get, so overrides apply) or a
+// file shipped with the pack.
+type File struct {
+  Path string `json:"path"`
+  Template string `json:"template"`
+  Source string `json:"source"`
+  Vars map[string]string `json:"vars"`
+}
+
+// Variable is a value used to render file paths, contents and hooks. It's
+// prompted for when not given on the command line.
+type Variable struct {
+  Name string `json:"name"`
+  Prompt string `json:"prompt"`
+  Default string `json:"default"`
+}
+
+// Load returns the bundled pack with the given name, or loads one from a
+// directory, .zip, .tar.gz or .tgz path.
+func Load(name string) (*Pack, error) {
+  if p, ok := bundled[name]; ok {
+    return p, nil
+  }
+  fi, err := os.Stat(name)
+  if err != nil {
+    return nil, fmt.Errorf("packs: %q is not a bundled pack (%v) or a path: %v", name, strings.Join(Names(), ", "), err)
+  }
+  if fi.IsDir() {
+    return loadDir(name)
+  }
+  switch {
+  case strings.HasSuffix(name, ".zip"):
+    return loadZip(name)
+  case strings.HasSuffix(name, ".tar.gz"), strings.HasSuffix(name, ".tgz"):
+    return loadTarGz(name)
+  }
+  return nil, fmt.Errorf("packs: don't know how to load %v", name)
+}
+
+func parse(data []byte, open func(name string) ([]byte, error)) (*Pack, error) {
+  p := &Pack{}
+  if err := json.Unmarshal(data, p); err != nil {
+    return nil, fmt.Errorf("packs: bad %v: %v", Manifest, err)
+  }
+  p.open = open
+  return p, nil
+}
+
+func loadDir(dir string) (*Pack, error) {
+  data, err := ioutil.ReadFile(filepath.Join(dir, Manifest))
+  if err != nil {
+    return nil, err
+  }
+  return parse(data, func(name string) ([]byte, error) {
+    return ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+  })
+}
+
+// loadFiles builds a pack from archive contents. Archives may wrap everything
+// in a single top-level directory, in which case it's stripped.
+func loadFiles(archive string, files map[string][]byte) (*Pack, error) {
+  prefix := ""
+  if _, ok := files[Manifest]; !ok {
+    for name := range files {
+      if path.Base(name) == Manifest && strings.Count(name, "/") == 1 {
+        prefix = path.Dir(name) + "/"
+      }
+    }
+  }
+  data, ok := files[prefix+Manifest]
+  if !ok {
+    return nil, fmt.Errorf("packs: %v has no %v", archive, Manifest)
+  }
+  return parse(data, func(name string) ([]byte, error) {
+    data, ok := files[prefix+name]
+    if !ok {
+      return nil, fmt.Errorf("packs: %v has no file %v", archive, name)
+    }
+    return data, nil
+  })
+}
+
+func loadZip(archive string) (*Pack, error) {
+  r, err := zip.OpenReader(archive)
+  if err != nil {
+    return nil, err
+  }
+  defer r.Close()
+  files := make(map[string][]byte)
+  for _, f := range r.File {
+    if f.FileInfo().IsDir() {
+      continue
+    }
+    rc, err := f.Open()
+    if err != nil {
+      return nil, err
+    }
+    data, err := ioutil.ReadAll(rc)
+    rc.Close()
+    if err != nil {
+      return nil, err
+    }
+    files[f.Name] = data
+  }
+  return loadFiles(archive, files)
+}
+
+func loadTarGz(archive string) (*Pack, error) {
+  f, err := os.Open(archive)
+  if err != nil {
+    return nil, err
+  }
+  defer f.Close()
+  gz, err := gzip.NewReader(f)
+  if err != nil {
+    return nil, err
+  }
+  defer gz.Close()
+  files := make(map[string][]byte)
+  tr := tar.NewReader(gz)
+  for {
+    hdr, err := tr.Next()
+    if err == io.EOF {
+      break
+    }
+    if err != nil {
+      return nil, err
+    }
+    if hdr.Typeflag != tar.TypeReg {
+      continue
+    }
+    data, err := ioutil.ReadAll(tr)
+    if err != nil {
+      return nil, err
+    }
+    files[strings.TrimPrefix(hdr.Name, "./")] = data
+  }
+  return loadFiles(archive, files)
+}
+
+// Resolve returns the variables used to generate an app. Values in given win;
+// anything else the pack declares is prompted for on in/out, falling back to
+// the (rendered) default when the answer is empty or in is exhausted.
+func (p *Pack) Resolve(given map[string]string, in io.Reader, out io.Writer) map[string]string {
+  vars := make(map[string]string)
+  for k, v := range given {
+    vars[k] = v
+  }
+  r := bufio.NewReader(in)
+  for _, v := range p.Variables {
+    if _, ok := vars[v.Name]; ok {
+      continue
+    }
+    def := mustache.Render(v.Default, vars)
+    prompt := v.Prompt
+    if prompt == "" {
+      prompt = v.Name
+    }
+    fmt.Fprintf(out, "%v [%v]: ", prompt, def)
+    line, _ := r.ReadString('\n')
+    line = strings.TrimSpace(line)
+    if line == "" {
+      line = def
+    }
+    vars[v.Name] = line
+  }
+  return vars
+}
+
+// Generate writes the pack into dir using vars, then runs its hooks in dir.
+func (p *Pack) Generate(dir string, vars map[string]string) error {
+  if err := os.MkdirAll(dir, 0777); err != nil {
+    return err
+  }
+  for _, d := range p.Dirs {
+    dirname, err := inside(dir, mustache.Render(d, vars))
+    if err != nil {
+      return err
+    }
+    if err := os.MkdirAll(dirname, 0777); err != nil {
+      return err
+    }
+  }
+  for _, f := range p.Files {
+    if err := p.writeFile(dir, f, vars); err != nil {
+      return err
+    }
+  }
+  for _, hook := range p.Hooks {
+    if len(hook) == 0 {
+      continue
+    }
+    args := make([]string, len(hook))
+    for i, arg := range hook {
+      args[i] = mustache.Render(arg, vars)
+    }
+    cmd := exec.Command(args[0], args[1:]...)
+    cmd.Dir = dir
+    cmd.Stdout = os.Stdout
+    cmd.Stderr = os.Stderr
+    if err := cmd.Run(); err != nil {
+      return fmt.Errorf("packs: hook `%v` failed: %v", strings.Join(args, " "), err)
+    }
+  }
+  return nil
+}
+
+func (p *Pack) writeFile(dir string, f File, vars map[string]string) error {
+  var data []byte
+  var err error
+  switch {
+  case f.Template != "":
+    data, _, err = templates.Find(f.Template)
+  case f.Source != "" && p.open != nil:
+    data, err = p.open(f.Source)
+  default:
+    return fmt.Errorf("packs: %v has no template or source", f.Path)
+  }
+  if err != nil {
+    return err
+  }
+
+  fileVars := make(map[string]string)
+  for k, v := range vars {
+    fileVars[k] = v
+  }
+  for k, v := range f.Vars {
+    fileVars[k] = mustache.Render(v, vars)
+  }
+
+  filename, err := inside(dir, mustache.Render(f.Path, vars))
+  if err != nil {
+    return err
+  }
+  if err := os.MkdirAll(filepath.Dir(filename), 0777); err != nil {
+    return err
+  }
+  return ioutil.WriteFile(filename, []byte(mustache.Render(string(data), fileVars)), 0666)
+}
+
+// inside returns where a path of the pack goes in dir. Packs can come from
+// anywhere, so paths that are absolute or climb out of dir are refused.
+func inside(dir string, name string) (string, error) {
+  clean := filepath.Clean(filepath.FromSlash(name))
+  if filepath.IsAbs(clean) || filepath.VolumeName(clean) != "" || clean == ".." || strings.HasPrefix(clean, ".." + string(filepath.Separator)) {
+    return "", fmt.Errorf("packs: %v is outside the app", name)
+  }
+  return filepath.Join(dir, clean), nil
+}
@@ -0,0 +1,53 @@
+package packs
+
+import (
+  "io/ioutil"
+  "os"
+  "path/filepath"
+  "strings"
+  "testing"
+)
+
+func TestInside(t *testing.T) {
+  tests := []struct {
+    name string
+    want string
+    ok bool
+  }{
+    {"conf/routes.go", "app/conf/routes.go", true},
+    {"./app/../conf", "app/conf", true},
+    {"a/../../b", "", false},
+    {"..", "", false},
+    {"../evil.go", "", false},
+    {"/etc/passwd", "", false},
+  }
+  for _, test := range tests {
+    got, err := inside("app", test.name)
+    if (err == nil) != test.ok {
+      t.Errorf("inside(%q) error = %v, want ok %v", test.name, err, test.ok)
+      continue
+    }
+    if test.ok && got != filepath.FromSlash(test.want) {
+      t.Errorf("inside(%q) = %q, want %q", test.name, got, test.want)
+    }
+  }
+}
+
+func TestGenerateRefusesPathsOutsideTheApp(t *testing.T) {
+  dir := t.TempDir()
+  src := filepath.Join(dir, "pack")
+  os.MkdirAll(src, 0777)
+  ioutil.WriteFile(filepath.Join(src, Manifest), []byte(`{"files": [{"path": "../escaped.go", "source": "x.go"}]}`), 0666)
+  ioutil.WriteFile(filepath.Join(src, "x.go"), []byte("package x\n"), 0666)
+  p, err := Load(src)
+  if err != nil {
+    t.Fatal(err)
+  }
+  err = p.Generate(filepath.Join(dir, "app"), map[string]string{})
+  if err == nil || !strings.Contains(err.Error(), "outside the app") {
+    t.Errorf("Generate() = %v, want an error for ../escaped.go", err)
+  }
+  if _, err := os.Stat(filepath.Join(dir, "escaped.go")); err == nil {
+    t.Errorf("escaped.go was written outside the app")
+  }
+}
@@ -0,0 +1,429 @@
+// Package params provides the request parameter binding eg generates for an
+// app's actions. Each action gets a function that reads its parameters from
+// the request with a Binder, parsing them into their real types, and the
+// values that don't parse are answered with a 400 listing them by field.
+//
+// The binding only validates: ego has no hook to call an action with the
+// values, so the parsed ones are dropped and ego binds the arguments itself.
+// The generated handler runs the binders in dev, where eg runs the app.
+package params
+
+import (
+  "encoding/json"
+  "fmt"
+  "math"
+  "mime"
+  "net/http"
+  "reflect"
+  "strconv"
+  "strings"
+  "time"
+  "unicode"
+)
+
+// Binder reads the parameters of a request: from the path, then the JSON
+// body, then the form and query. The Binder of a struct parameter reads its
+// fields from the parameter's JSON object or name[field] form values.
+type Binder struct {
+  path map[string]string
+  body map[string]interface{}
+  form map[string][]string
+  prefix string
+  errs *Errors
+}
+
+// New returns a Binder for a request and the values of its path parameters.
+// A JSON body that can't be read is reported as an error of the "body" field.
+func New(r *http.Request, path map[string]string) *Binder {
+  b := &Binder{path: path, errs: &Errors{}}
+  ctype, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+  if ctype == "application/json" && r.Body != nil {
+    dec := json.NewDecoder(r.Body)
+    dec.UseNumber()
+    if err := dec.Decode(&b.body); err != nil {
+      b.errs.Add("body", "must be a JSON object")
+    }
+  } else if ctype == "multipart/form-data" {
+    r.ParseMultipartForm(32 << 20)
+  }
+  r.ParseForm()
+  b.form = r.Form
+  return b
+}
+
+// Struct returns the Binder of the fields of the struct parameter name.
+func (b *Binder) Struct(name string) *Binder {
+  s := &Binder{path: b.path, form: b.form, prefix: b.field(name), errs: b.errs}
+  if obj, ok := b.body[name].(map[string]interface{}); ok {
+    s.body = obj
+  } else if b.prefix == "" {
+    // A JSON body can be the struct itself, {"title": ...} for a post.
+    s.body = b.body
+  }
+  return s
+}
+
+// Err returns the parameters that couldn't be bound, or nil.
+func (b *Binder) Err() *Errors {
+  if len(b.errs.Fields) == 0 {
+    return nil
+  }
+  return b.errs
+}
+
+// field returns the name errors report a parameter by, post[title] for the
+// title of the post parameter.
+func (b *Binder) field(name string) string {
+  if b.prefix == "" {
+    return name
+  }
+  return b.prefix + "[" + name + "]"
+}
+
+// lookup returns the values given for a parameter, and whether it was given.
+func (b *Binder) lookup(name string) ([]string, bool) {
+  if v, ok := b.body[name]; ok && v != nil {
+    return jsonValues(v), true
+  }
+  keys := []string{name, name + "[]"}
+  if b.prefix != "" {
+    full := b.field(name)
+    keys = []string{full, full + "[]", b.prefix + "." + name}
+  }
+  for _, k := range keys {
+    if v, ok := b.form[k]; ok {
+      return v, true
+    }
+  }
+  if v, ok := b.path[name]; ok && (b.prefix == "" || !strings.Contains(b.prefix, "[")) {
+    return []string{v}, true
+  }
+  return nil, false
+}
+
+// jsonValues returns the values of a JSON value as text, the elements of an
+// array being values of their own.
+func jsonValues(v interface{}) []string {
+  switch x := v.(type) {
+  case string:
+    return []string{x}
+  case json.Number:
+    return []string{x.String()}
+  case bool:
+    return []string{strconv.FormatBool(x)}
+  case []interface{}:
+    values := make([]string, 0, len(x))
+    for _, e := range x {
+      values = append(values, jsonValues(e)...)
+    }
+    return values
+  }
+  data, _ := json.Marshal(v)
+  return []string{string(data)}
+}
+
+// missing reports whether a parameter wasn't given a value. An empty string
+// is only a value for string parameters.
+func missing(values []string, ok bool, str bool) bool {
+  return !ok || len(values) == 0 || (len(values) == 1 && values[0] == "" && !str)
+}
+
+// One returns the value of a parameter parsed with parse, or the zero value if
+// it's not given. Errors are collected in the Binder.
+func One[T any](b *Binder, name string, required bool, parse func(string) (T, error)) T {
+  var v T
+  values, ok := b.lookup(name)
+  if missing(values, ok, isString(v)) {
+    if required {
+      b.errs.Add(b.field(name), "is required")
+    }
+    return v
+  }
+  v, err := parse(values[0])
+  if err != nil {
+    b.errs.Add(b.field(name), err.Error())
+  }
+  return v
+}
+
+// Many returns the values of a list parameter, given as an array in JSON or
+// repeated as name or name[] in forms.
+func Many[T any](b *Binder, name string, required bool, parse func(string) (T, error)) []T {
+  values, ok := b.lookup(name)
+  if !ok || len(values) == 0 {
+    if required {
+      b.errs.Add(b.field(name), "is required")
+    }
+    return nil
+  }
+  list := make([]T, 0, len(values))
+  for i, s := range values {
+    v, err := parse(s)
+    if err != nil {
+      b.errs.Add(fmt.Sprintf("%v[%v]", b.field(name), i), err.Error())
+      continue
+    }
+    list = append(list, v)
+  }
+  return list
+}
+
+// Ptr returns a pointer to the value of an optional parameter, or nil if it's
+// not given.
+func Ptr[T any](b *Binder, name string, required bool, parse func(string) (T, error)) *T {
+  var zero T
+  values, ok := b.lookup(name)
+  if missing(values, ok, isString(zero)) {
+    if required {
+      b.errs.Add(b.field(name), "is required")
+    }
+    return nil
+  }
+  v := One(b, name, required, parse)
+  return &v
+}
+
+func isString(v interface{}) bool {
+  _, ok := v.(string)
+  return ok
+}
+
+// FieldError is a parameter that couldn't be bound.
+type FieldError struct {
+  Field string `json:"field"`
+  Message string `json:"message"`
+}
+
+// Errors are the parameters of a request that couldn't be bound, in the
+// order they were read.
+type Errors struct {
+  Fields []FieldError `json:"errors"`
+}
+
+func (e *Errors) Add(field string, message string) {
+  e.Fields = append(e.Fields, FieldError{Field: field, Message: message})
+}
+
+func (e *Errors) Error() string {
+  msgs := make([]string, len(e.Fields))
+  for i, f := range e.Fields {
+    msgs[i] = f.Field + " " + f.Message
+  }
+  return "invalid parameters: " + strings.Join(msgs, ", ")
+}
+
+// Write answers the request with a 400, as JSON if the request sent or
+// accepts it and as text otherwise.
+func (e *Errors) Write(w http.ResponseWriter, r *http.Request) {
+  if strings.Contains(r.Header.Get("Accept"), "json") || strings.Contains(r.Header.Get("Content-Type"), "json") {
+    w.Header().Set("Content-Type", "application/json; charset=utf-8")
+    w.WriteHeader(http.StatusBadRequest)
+    json.NewEncoder(w).Encode(e)
+    return
+  }
+  w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+  w.WriteHeader(http.StatusBadRequest)
+  fmt.Fprintln(w, "400 Bad Request")
+  fmt.Fprintln(w)
+  for _, f := range e.Fields {
+    fmt.Fprintf(w, "%v %v\n", f.Field, f.Message)
+  }
+}
+
+// Handler returns the function an action's binder is registered with: it
+// binds the parameters of a request, or writes the 400 and returns nil.
+func Handler(bind func(b *Binder) []reflect.Value) func(w http.ResponseWriter, r *http.Request, path map[string]string) []reflect.Value {
+  return func(w http.ResponseWriter, r *http.Request, path map[string]string) []reflect.Value {
+    b := New(r, path)
+    args := bind(b)
+    if errs := b.Err(); errs != nil {
+      errs.Write(w, r)
+      return nil
+    }
+    return args
+  }
+}
+
+// Key returns the name a struct field is bound from: the name in its param
+// tag, or else its json tag, or else the field name in snake case. It's empty
+// for fields tagged "-".
+func Key(field string, tag reflect.StructTag) (key string, required bool) {
+  for _, t := range []string{"param", "json"} {
+    v, ok := tag.Lookup(t)
+    if !ok {
+      continue
+    }
+    opts := strings.Split(v, ",")
+    if opts[0] == "-" && len(opts) == 1 {
+      return "", false
+    }
+    for _, o := range opts[1:] {
+      required = required || (t == "param" && o == "required")
+    }
+    if opts[0] != "" {
+      return opts[0], required
+    }
+  }
+  return snakeCase(field), required
+}
+
+// snakeCase turns PublishedAt into published_at and UserID into user_id.
+func snakeCase(name string) string {
+  runes := []rune(name)
+  var b strings.Builder
+  for i, r := range runes {
+    if unicode.IsUpper(r) && i > 0 {
+      prev := runes[i - 1]
+      nextLower := i + 1 < len(runes) && unicode.IsLower(runes[i + 1])
+      if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+        b.WriteByte('_')
+      }
+    }
+    b.WriteRune(unicode.ToLower(r))
+  }
+  return b.String()
+}
+
+// The parsers of the types eg binds, with the messages of their errors.
+
+func ParseString(s string) (string, error) {
+  return s, nil
+}
+
+func ParseBool(s string) (bool, error) {
+  switch strings.ToLower(s) {
+  case "1", "t", "true", "on", "yes":
+    return true, nil
+  case "0", "f", "false", "off", "no":
+    return false, nil
+  }
+  return false, fmt.Errorf("must be true or false")
+}
+
+func parseInt(s string, bits int) (int64, error) {
+  n, err := strconv.ParseInt(s, 10, bits)
+  if err != nil {
+    if e, ok := err.(*strconv.NumError); ok && e.Err == strconv.ErrRange {
+      max := int64(uint64(math.MaxUint64) >> (65 - bits))
+      return 0, fmt.Errorf("must be an integer between %v and %v", -max - 1, max)
+    }
+    return 0, fmt.Errorf("must be an integer")
+  }
+  return n, nil
+}
+
+func parseUint(s string, bits int) (uint64, error) {
+  n, err := strconv.ParseUint(s, 10, bits)
+  if err != nil {
+    if e, ok := err.(*strconv.NumError); ok && e.Err == strconv.ErrRange {
+      return 0, fmt.Errorf("must be an integer between 0 and %v", uint64(math.MaxUint64) >> (64 - bits))
+    }
+    return 0, fmt.Errorf("must be a positive integer")
+  }
+  return n, nil
+}
+
+func ParseInt(s string) (int, error) {
+  n, err := parseInt(s, strconv.IntSize)
+  return int(n), err
+}
+
+func ParseInt8(s string) (int8, error) {
+  n, err := parseInt(s, 8)
+  return int8(n), err
+}
+
+func ParseInt16(s string) (int16, error) {
+  n, err := parseInt(s, 16)
+  return int16(n), err
+}
+
+func ParseInt32(s string) (int32, error) {
+  n, err := parseInt(s, 32)
+  return int32(n), err
+}
+
+func ParseInt64(s string) (int64, error) {
+  return parseInt(s, 64)
+}
+
+func ParseUint(s string) (uint, error) {
+  n, err := parseUint(s, strconv.IntSize)
+  return uint(n), err
+}
+
+func ParseUint8(s string) (uint8, error) {
+  n, err := parseUint(s, 8)
+  return uint8(n), err
+}
+
+func ParseUint16(s string) (uint16, error) {
+  n, err := parseUint(s, 16)
+  return uint16(n), err
+}
+
+func ParseUint32(s string) (uint32, error) {
+  n, err := parseUint(s, 32)
+  return uint32(n), err
+}
+
+func ParseUint64(s string) (uint64, error) {
+  return parseUint(s, 64)
+}
+
+func ParseFloat32(s string) (float32, error) {
+  f, err := strconv.ParseFloat(s, 32)
+  if err != nil {
+    return 0, fmt.Errorf("must be a number")
+  }
+  return float32(f), nil
+}
+
+func ParseFloat64(s string) (float64, error) {
+  f, err := strconv.ParseFloat(s, 64)
+  if err != nil {
+    return 0, fmt.Errorf("must be a number")
+  }
+  return f, nil
+}
+
+// timeLayouts are what ParseTime accepts: RFC 3339, and the values of the
+// datetime-local and date inputs.
+var timeLayouts = []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02T15:04", "2006-01-02"}
+
+func ParseTime(s string) (time.Time, error) {
+  for _, layout := range timeLayouts {
+    if t, err := time.Parse(layout, s); err == nil {
+      return t, nil
+    }
+  }
+  return time.Time{}, fmt.Errorf("must be a time such as 2006-01-02T15:04:05Z or 2006-01-02")
+}
+
+func ParseDuration(s string) (time.Duration, error) {
+  d, err := time.ParseDuration(s)
+  if err != nil {
+    return 0, fmt.Errorf("must be a duration such as 1h30m")
+  }
+  return d, nil
+}
+
+// Parsers are the parse functions of the types eg binds, by type name.
+var Parsers = map[string]string{
+  "string": "ParseString",
+  "bool": "ParseBool",
+  "int": "ParseInt",
+  "int8": "ParseInt8",
+  "int16": "ParseInt16",
+  "int32": "ParseInt32",
+  "int64": "ParseInt64",
+  "uint": "ParseUint",
+  "uint8": "ParseUint8",
+  "uint16": "ParseUint16",
+  "uint32": "ParseUint32",
+  "uint64": "ParseUint64",
+  "float32": "ParseFloat32",
+  "float64": "ParseFloat64",
+  "time.Time": "ParseTime",
+  "time.Duration": "ParseDuration",
+}
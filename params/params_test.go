@@ -0,0 +1,162 @@
+package params
+
+import (
+  "encoding/json"
+  "net/http"
+  "net/http/httptest"
+  "net/url"
+  "reflect"
+  "strings"
+  "testing"
+  "time"
+)
+
+func TestBindForm(t *testing.T) {
+  form := url.Values{
+    "title": {"Hello"},
+    "post[title]": {"Nested"},
+    "post[tags][]": {"a", "b"},
+    "ids": {"1", "2"},
+    "draft": {"on"},
+    "at": {"2024-05-01"},
+    "empty": {""},
+  }
+  r := httptest.NewRequest("POST", "/posts/7?page=2", strings.NewReader(form.Encode()))
+  r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+  b := New(r, map[string]string{"id": "7"})
+
+  if id := One(b, "id", false, ParseInt); id != 7 {
+    t.Errorf("id = %v, want 7 from the path", id)
+  }
+  if page := One(b, "page", false, ParseInt); page != 2 {
+    t.Errorf("page = %v, want 2 from the query", page)
+  }
+  if ids := Many(b, "ids", false, ParseInt64); !reflect.DeepEqual(ids, []int64{1, 2}) {
+    t.Errorf("ids = %v, want [1 2]", ids)
+  }
+  if draft := Ptr(b, "draft", false, ParseBool); draft == nil || !*draft {
+    t.Errorf("draft = %v, want true", draft)
+  }
+  if at := One(b, "at", false, ParseTime); !at.Equal(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)) {
+    t.Errorf("at = %v, want 2024-05-01", at)
+  }
+  if n := Ptr(b, "empty", false, ParseInt); n != nil {
+    t.Errorf("empty = %v, want nil as an empty value isn't an int", *n)
+  }
+  post := b.Struct("post")
+  if title := One(post, "title", false, ParseString); title != "Nested" {
+    t.Errorf("post[title] = %v, want Nested", title)
+  }
+  if tags := Many(post, "tags", false, ParseString); !reflect.DeepEqual(tags, []string{"a", "b"}) {
+    t.Errorf("post[tags] = %v, want [a b]", tags)
+  }
+  if err := b.Err(); err != nil {
+    t.Errorf("Err = %v", err)
+  }
+}
+
+func TestBindJSON(t *testing.T) {
+  body := `{"title": "Hi", "views": 12, "public": true, "ids": [1, 2], "author": {"name": "Ann"}}`
+  r := httptest.NewRequest("POST", "/posts", strings.NewReader(body))
+  r.Header.Set("Content-Type", "application/json; charset=utf-8")
+  b := New(r, nil)
+
+  // A struct parameter is read from the body itself when it has no key of
+  // its own, and nested structs from their objects.
+  post := b.Struct("post")
+  if title := One(post, "title", true, ParseString); title != "Hi" {
+    t.Errorf("title = %v, want Hi", title)
+  }
+  if views := One(post, "views", false, ParseUint32); views != 12 {
+    t.Errorf("views = %v, want 12", views)
+  }
+  if public := One(post, "public", false, ParseBool); !public {
+    t.Errorf("public = %v, want true", public)
+  }
+  if ids := Many(b, "ids", false, ParseInt); !reflect.DeepEqual(ids, []int{1, 2}) {
+    t.Errorf("ids = %v, want [1 2]", ids)
+  }
+  if name := One(post.Struct("author"), "name", false, ParseString); name != "Ann" {
+    t.Errorf("author name = %v, want Ann", name)
+  }
+  if err := b.Err(); err != nil {
+    t.Errorf("Err = %v", err)
+  }
+}
+
+func TestErrors(t *testing.T) {
+  r := httptest.NewRequest("GET", "/posts?id=abc&n=300&ids=1&ids=x&at=soon", nil)
+  b := New(r, nil)
+  One(b, "id", false, ParseInt)
+  One(b, "n", false, ParseInt8)
+  Many(b, "ids", false, ParseInt)
+  One(b, "at", false, ParseTime)
+  One(b.Struct("post"), "title", true, ParseString)
+
+  want := []FieldError{
+    {"id", "must be an integer"},
+    {"n", "must be an integer between -128 and 127"},
+    {"ids[1]", "must be an integer"},
+    {"at", "must be a time such as 2006-01-02T15:04:05Z or 2006-01-02"},
+    {"post[title]", "is required"},
+  }
+  if err := b.Err(); err == nil || !reflect.DeepEqual(err.Fields, want) {
+    t.Fatalf("Err = %+v, want %+v", err, want)
+  }
+
+  w := httptest.NewRecorder()
+  b.Err().Write(w, r)
+  if w.Code != http.StatusBadRequest || !strings.Contains(w.Body.String(), "ids[1] must be an integer\n") {
+    t.Errorf("text response = %v %q", w.Code, w.Body)
+  }
+
+  r.Header.Set("Accept", "application/json")
+  w = httptest.NewRecorder()
+  b.Err().Write(w, r)
+  var got Errors
+  if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil || !reflect.DeepEqual(got.Fields, want) {
+    t.Errorf("JSON response = %s", w.Body)
+  }
+}
+
+func TestHandler(t *testing.T) {
+  h := Handler(func(b *Binder) []reflect.Value {
+    return []reflect.Value{reflect.ValueOf(One(b, "id", false, ParseInt))}
+  })
+  w := httptest.NewRecorder()
+  if args := h(w, httptest.NewRequest("GET", "/", nil), map[string]string{"id": "5"}); len(args) != 1 || args[0].Int() != 5 {
+    t.Errorf("args = %v, want [5]", args)
+  }
+  w = httptest.NewRecorder()
+  if args := h(w, httptest.NewRequest("GET", "/", nil), map[string]string{"id": "five"}); args != nil || w.Code != 400 {
+    t.Errorf("args = %v and status %v, want a 400", args, w.Code)
+  }
+
+  r := httptest.NewRequest("POST", "/", strings.NewReader("{"))
+  r.Header.Set("Content-Type", "application/json")
+  w = httptest.NewRecorder()
+  if args := h(w, r, nil); args != nil || !strings.Contains(w.Body.String(), `"field":"body"`) {
+    t.Errorf("a broken JSON body was answered with %v %s", w.Code, w.Body)
+  }
+}
+
+func TestKey(t *testing.T) {
+  for _, c := range []struct {
+    field string
+    tag reflect.StructTag
+    key string
+    required bool
+  }{
+    {"PublishedAt", "", "published_at", false},
+    {"UserID", "", "user_id", false},
+    {"Title", `json:"headline,omitempty"`, "headline", false},
+    {"Title", `param:"name,required" json:"headline"`, "name", true},
+    {"Title", `param:",required"`, "title", true},
+    {"Secret", `json:"-"`, "", false},
+  } {
+    key, required := Key(c.field, c.tag)
+    if key != c.key || required != c.required {
+      t.Errorf("Key(%v, %v) = %q, %v, want %q, %v", c.field, c.tag, key, required, c.key, c.required)
+    }
+  }
+}
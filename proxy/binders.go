@@ -0,0 +1,167 @@
+package proxy
+
+import (
+  "fmt"
+  "go/ast"
+  "reflect"
+  "strings"
+  "github.com/murz/eg/inspector"
+  "github.com/murz/eg/params"
+)
+
+// maxStructDepth bounds how deep binders go into the fields of structs, so a
+// type that refers to itself doesn't recurse forever.
+const maxStructDepth = 4
+
+// binderGen writes the body of the binder of one action.
+type binderGen struct {
+  lines []string
+  models bool
+  controllers bool
+}
+
+// binders returns the data of params.go: a binder for each action with
+// parameters whose types can all be bound. The others are left to the
+// server's reflection.
+func binders() map[string]interface{} {
+  list := make([]map[string]interface{}, 0)
+  models, controllers := false, false
+  for _, a := range inspector.GetActions() {
+    if len(a.Fields) == 0 {
+      continue
+    }
+    g := &binderGen{}
+    args := make([]string, 0, len(a.Fields))
+    ok := true
+    for _, f := range a.Fields {
+      v := f.Key + "Param"
+      if !g.param(v, f.Key, f.Value) {
+        buildLog.Debugf("%v.%v: %v %v is bound at runtime", a.Controller, a.Name, f.Key, f.Value)
+        ok = false
+        break
+      }
+      args = append(args, "reflect.ValueOf(" + v + ")")
+    }
+    if !ok {
+      continue
+    }
+    models = models || g.models
+    controllers = controllers || g.controllers
+    lines := make([]map[string]string, len(g.lines))
+    for i, l := range g.lines {
+      lines[i] = map[string]string{"Line": l}
+    }
+    list = append(list, map[string]interface{}{
+      "Action": a.Controller + "." + a.Name,
+      "Func": "bind" + a.Controller + a.Name,
+      "Lines": lines,
+      "Args": strings.Join(args, ", "),
+    })
+  }
+  return map[string]interface{}{
+    "Binders": list,
+    "HasBinders": len(list) > 0,
+    "BindsModels": models,
+    "BindsControllers": controllers,
+  }
+}
+
+// aliases are the type names params.Parsers doesn't list by.
+var aliases = map[string]string{
+  "byte": "uint8",
+  "rune": "int32",
+}
+
+// value returns the expression that binds a parameter of a basic type, a
+// slice of one or a pointer to one.
+func value(b string, key string, typ string, required bool) (string, bool) {
+  kind, elem := "One", typ
+  if strings.HasPrefix(typ, "[]") {
+    kind, elem = "Many", typ[2:]
+  } else if strings.HasPrefix(typ, "*") {
+    kind, elem = "Ptr", typ[1:]
+  }
+  if alias, ok := aliases[elem]; ok {
+    elem = alias
+  }
+  parser, ok := params.Parsers[elem]
+  if !ok || (kind == "Many" && elem == "uint8") {
+    return "", false
+  }
+  return fmt.Sprintf("params.%v(%v, %q, %v, params.%v)", kind, b, key, required, parser), true
+}
+
+// qualify returns how the generated package refers to a type of pkg, which
+// may be a slice of or pointer to one.
+func qualify(typ string, pkg string) string {
+  for _, prefix := range []string{"[]", "*"} {
+    if strings.HasPrefix(typ, prefix) {
+      return prefix + qualify(typ[len(prefix):], pkg)
+    }
+  }
+  if strings.Contains(typ, ".") || params.Parsers[typ] != "" || aliases[typ] != "" {
+    return typ
+  }
+  return pkg + "." + typ
+}
+
+// param adds the lines that bind the action parameter key to the variable v.
+func (g *binderGen) param(v string, key string, typ string) bool {
+  if expr, ok := value("b", key, typ, false); ok {
+    g.lines = append(g.lines, v + " := " + expr)
+    return true
+  }
+  ptr := strings.HasPrefix(typ, "*")
+  name := qualify(strings.TrimPrefix(typ, "*"), "controllers")
+  if inspector.GetStruct(name) == nil {
+    return false
+  }
+  if ptr {
+    g.lines = append(g.lines, v + " := &" + name + "{}")
+  } else {
+    g.lines = append(g.lines, "var " + v + " " + name)
+  }
+  g.use(name)
+  g.structFields(v, key, name, 0)
+  return true
+}
+
+// structFields adds the lines that bind the fields of the struct typ, read
+// with the Binder of key, to target.
+func (g *binderGen) structFields(target string, key string, typ string, depth int) {
+  indent := strings.Repeat("\t", depth + 1)
+  pkg := strings.Split(typ, ".")[0]
+  g.lines = append(g.lines, indent[1:] + "{", fmt.Sprintf("%vb := b.Struct(%q)", indent, key))
+  for _, f := range inspector.GetStruct(typ).Fields {
+    fkey, required := params.Key(f.Key, reflect.StructTag(f.Tag))
+    if fkey == "" || !ast.IsExported(f.Key) {
+      continue
+    }
+    field := target + "." + f.Key
+    if expr, ok := value("b", fkey, qualify(f.Value, pkg), required); ok {
+      g.lines = append(g.lines, indent + field + " = " + expr)
+      continue
+    }
+    name := strings.TrimPrefix(qualify(f.Value, pkg), "*")
+    if depth + 1 >= maxStructDepth || inspector.GetStruct(name) == nil {
+      buildLog.Debugf("%v.%v %v isn't bound", typ, f.Key, f.Value)
+      continue
+    }
+    if strings.HasPrefix(f.Value, "*") {
+      g.lines = append(g.lines, indent + field + " = &" + name + "{}")
+    }
+    g.use(name)
+    g.structFields(field, fkey, name, depth + 1)
+  }
+  g.lines = append(g.lines, indent[1:] + "}")
+}
+
+// use records the package of a type the binder declares.
+func (g *binderGen) use(typ string) {
+  switch strings.Split(typ, ".")[0] {
+  case "models":
+    g.models = true
+  case "controllers":
+    g.controllers = true
+  }
+}
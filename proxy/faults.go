@@ -0,0 +1,146 @@
+package proxy
+
+import (
+  "encoding/json"
+  "fmt"
+  "io"
+  "net/http"
+  "net/url"
+  "strconv"
+  "strings"
+  "time"
+  "github.com/hoisie/mustache"
+  "github.com/murz/eg/faults"
+  "github.com/murz/eg/templates"
+)
+
+// fault injects the faults of the first matching rule before passing the
+// request on.
+func (p *Proxy) fault(next http.Handler) http.Handler {
+  return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+    rule := p.faults.Find(r)
+    if rule == nil {
+      next.ServeHTTP(w, r)
+      return
+    }
+    applied := make([]string, 0)
+    note := func(what string) {
+      applied = append(applied, what)
+      if e := entryFor(r); e != nil {
+        p.requests.update(e, func(e *Entry) {
+          e.Fault = strings.Join(applied, ", ")
+        })
+      }
+    }
+    if d := rule.Delay(); d > 0 {
+      time.Sleep(d)
+      note(fmt.Sprintf("+%v", d.Round(time.Millisecond)))
+    }
+    if rule.Drop() {
+      note("dropped")
+      panic(http.ErrAbortHandler)
+    }
+    if fail, status := rule.Fail(); fail {
+      note(fmt.Sprintf("injected %v", status))
+      http.Error(w, fmt.Sprintf("eg: injected %v for %v", status, rule), status)
+      return
+    }
+    if rule.Bandwidth > 0 {
+      note(fmt.Sprintf("%v B/s", rule.Bandwidth))
+      w = faults.Throttle(w, rule.Bandwidth)
+    }
+    next.ServeHTTP(w, r)
+  })
+}
+
+// serveFaults shows the fault rules at /_eg/faults, with a form to change
+// them.
+func (p *Proxy) serveFaults(w http.ResponseWriter, r *http.Request) {
+  if r.Method == "POST" {
+    if !sameOrigin(r) {
+      http.Error(w, "eg: other sites can't change the faults", http.StatusForbidden)
+      return
+    }
+    if err := p.changeFaults(r); err != nil {
+      http.Error(w, err.Error(), http.StatusBadRequest)
+      return
+    }
+    http.Redirect(w, r, "/_eg/faults", http.StatusSeeOther)
+    return
+  }
+  rules := make([]map[string]interface{}, 0)
+  for i, rule := range p.faults.Rules() {
+    rules = append(rules, map[string]interface{} {
+      "Index": i,
+      "Rule": rule.String(),
+    })
+  }
+  w.Header().Set("Content-Type", "text/html; charset=utf-8")
+  io.WriteString(w, mustache.Render(string(templates.Get("faults.html.mustache")), map[string]interface{} {
+    "Rules": rules,
+  }))
+}
+
+// serveFaultsJSON is the API `eg faults` uses: GET lists the rules, POST adds
+// one from form values and DELETE clears them.
+func (p *Proxy) serveFaultsJSON(w http.ResponseWriter, r *http.Request) {
+  if r.Method != "GET" && !sameOrigin(r) {
+    http.Error(w, "eg: other sites can't change the faults", http.StatusForbidden)
+    return
+  }
+  var err error
+  switch r.Method {
+  case "POST":
+    err = p.changeFaults(r)
+  case "DELETE":
+    p.faults.Clear()
+  }
+  if err != nil {
+    http.Error(w, err.Error(), http.StatusBadRequest)
+    return
+  }
+  w.Header().Set("Content-Type", "application/json")
+  json.NewEncoder(w).Encode(p.faults.Rules())
+}
+
+// sameOrigin reports whether a request comes from the proxy's own pages or
+// from outside a browser, like `eg faults`, which sends neither header. A page
+// of another site could otherwise change the faults with a form.
+func sameOrigin(r *http.Request) bool {
+  switch r.Header.Get("Sec-Fetch-Site") {
+  case "", "same-origin", "none":
+  default:
+    return false
+  }
+  origin := r.Header.Get("Origin")
+  if origin == "" {
+    return true
+  }
+  u, err := url.Parse(origin)
+  return err == nil && u.Host == r.Host
+}
+
+// changeFaults adds a rule from the form values, or removes the rule given by
+// remove, or clears them all.
+func (p *Proxy) changeFaults(r *http.Request) error {
+  if err := r.ParseForm(); err != nil {
+    return err
+  }
+  if r.Form.Get("clear") != "" {
+    p.faults.Clear()
+    return nil
+  }
+  if s := r.Form.Get("remove"); s != "" {
+    i, err := strconv.Atoi(s)
+    if err != nil {
+      return fmt.Errorf("faults: bad rule %q", s)
+    }
+    p.faults.Remove(i)
+    return nil
+  }
+  rule, err := faults.Parse(r.Form)
+  if err != nil {
+    return err
+  }
+  return p.faults.Add(rule)
+}
@@ -0,0 +1,42 @@
+package proxy
+
+import (
+  "net/http"
+  "github.com/murz/eg/mocks"
+)
+
+// loadMocks reads the rules in conf/mocks. If they can't be read the old
+// rules are kept.
+func (p *Proxy) loadMocks() {
+  rules, err := mocks.Load(mocks.Dir)
+  if err != nil {
+    proxyLog.Errorf("mocks: %v", err)
+    return
+  }
+  p.mockLock.Lock()
+  p.mocks = rules
+  p.mockLock.Unlock()
+  if len(rules) > 0 {
+    proxyLog.Infof("mocks: %v rules from %v", len(rules), mocks.Dir)
+  }
+}
+
+// mock answers requests that match a rule in conf/mocks and passes the rest
+// on to the app.
+func (p *Proxy) mock(next http.Handler) http.Handler {
+  return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+    p.mockLock.RLock()
+    rule := mocks.Find(p.mocks, r)
+    p.mockLock.RUnlock()
+    if rule == nil {
+      next.ServeHTTP(w, r)
+      return
+    }
+    if e := entryFor(r); e != nil {
+      p.requests.update(e, func(e *Entry) {
+        e.Mock = rule.Describe()
+      })
+    }
+    rule.ServeHTTP(w, r)
+  })
+}
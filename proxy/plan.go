@@ -0,0 +1,222 @@
+package proxy
+
+import (
+  "crypto/sha256"
+  "encoding/hex"
+  "encoding/json"
+  "fmt"
+  "io"
+  "io/ioutil"
+  "os"
+  "path/filepath"
+  "sort"
+  "strings"
+  "time"
+  "github.com/murz/eg/db"
+  "github.com/murz/eg/inspector"
+  "github.com/murz/eg/templates"
+  "github.com/murz/eg/views"
+)
+
+// stateFile keeps the hashes of the last successful build, so eg can tell
+// what a change needs.
+const stateFile = ".ego-genfiles/build.json"
+
+type buildState struct {
+  // Model is the hash of everything server.go is rendered from: the inspected
+  // App model and the server template.
+  Model string `json:"model"`
+  // Sources is the hash of the app's Go files, go.mod and go.sum, and of its
+  // views outside of dev mode, where they're embedded in the binary.
+  Sources string `json:"sources"`
+  // Dev is set when the build was for dev mode, without the views in Sources.
+  Dev bool `json:"dev"`
+}
+
+func readState() buildState {
+  var s buildState
+  if data, err := ioutil.ReadFile(stateFile); err == nil {
+    json.Unmarshal(data, &s)
+  }
+  return s
+}
+
+func writeState(s buildState) error {
+  data, err := json.Marshal(s)
+  if err != nil {
+    return err
+  }
+  return ioutil.WriteFile(stateFile, data, 0666)
+}
+
+// Stale reports what a build of the app in the working directory would redo,
+// going by the hashes build.json keeps of the last one: generate, if what
+// server.go is rendered from changed, and compile, if the sources did. Both
+// are false for an app that was never built.
+func Stale() (generate bool, compile bool, err error) {
+  last := readState()
+  if last.Model == "" {
+    return false, false, nil
+  }
+  defer func() {
+    if r := recover(); r != nil {
+      err = fmt.Errorf("%v", r)
+    }
+  }()
+  inspector.InitActions()
+  inspector.Inspect()
+  wd, _ := os.Getwd()
+  sources, err := sourceHash(wd, !last.Dev)
+  if err != nil {
+    return false, false, err
+  }
+  generate = modelHash(defaultProxy.serverData()) != last.Model
+  return generate, generate || sources != last.Sources, nil
+}
+
+// Timings are how long each phase of a build took. Generate and Compile are
+// skipped when their inputs haven't changed since the last build.
+type Timings struct {
+  Inspect time.Duration
+  Generate time.Duration
+  Compile time.Duration
+  Generated bool
+  Compiled bool
+}
+
+func (t Timings) String() string {
+  phase := func(name string, d time.Duration, ran bool) string {
+    if !ran {
+      return name + " skipped"
+    }
+    return fmt.Sprintf("%v %v", name, d.Round(time.Millisecond))
+  }
+  return strings.Join([]string{
+    phase("inspect", t.Inspect, true),
+    phase("generate", t.Generate, t.Generated),
+    phase("compile", t.Compile, t.Compiled),
+  }, ", ")
+}
+
+// needsBuild reports whether a change to filename can change the binary.
+// Anything else, like a view, is picked up by the running app.
+func needsBuild(filename string) bool {
+  base := filepath.Base(filename)
+  return filepath.Ext(base) == ".go" || base == "go.mod" || base == "go.sum"
+}
+
+// plan inspects the app and checks its views and routes, and then regenerates
+// server.go and compiles only what changed since the last build.
+func (p *Proxy) plan() (Timings, error) {
+  var t Timings
+  start := time.Now()
+  inspector.InitActions()
+  inspector.Inspect()
+  vs, err := views.Discover(views.Dir)
+  if err != nil {
+    return t, err
+  }
+  if err := views.Check(vs, inspector.HelperNames()); err != nil {
+    return t, err
+  }
+  if err := inspector.CheckRoutes(); err != nil {
+    return t, err
+  }
+  t.Inspect = time.Since(start)
+
+  last := readState()
+  next := buildState{Dev: p.dev}
+  start = time.Now()
+  data := p.serverData()
+  next.Model = modelHash(data)
+  p.setPaths()
+  if _, err := os.Stat(filepath.Join(p.dir, "server.go")); err != nil || next.Model != last.Model {
+    p.writeServer(data)
+    t.Generated = true
+  }
+  if err := p.copyViews(vs); err != nil {
+    return t, err
+  }
+  modules := make([]string, 0)
+  if data["HasBinders"] == true || data["HasViews"] == true || data["HasErrorPages"] == true {
+    modules = append(modules, Module + "@" + ModuleVersion())
+  }
+  for _, d := range data["Drivers"].([]*db.Driver) {
+    modules = append(modules, d.Module)
+  }
+  if len(modules) > 0 {
+    if err := Require(p.root, modules...); err != nil {
+      return t, err
+    }
+  }
+  t.Generate = time.Since(start)
+
+  start = time.Now()
+  sources, err := sourceHash(p.root, !p.dev)
+  if err != nil {
+    return t, err
+  }
+  next.Sources = sources
+  if _, err := os.Stat(p.binPath); err != nil || next.Sources != last.Sources || t.Generated {
+    if err := p.build(); err != nil {
+      return t, err
+    }
+    t.Compiled = true
+  }
+  t.Compile = time.Since(start)
+  p.timings = t
+  return t, writeState(next)
+}
+
+func modelHash(data map[string]interface{}) string {
+  h := sha256.New()
+  json.NewEncoder(h).Encode(data)
+  h.Write(templates.Get("server.go.mustache"))
+  h.Write(templates.Get("handler.go.mustache"))
+  h.Write(templates.Get("views.go.mustache"))
+  h.Write(templates.Get("errors.go.mustache"))
+  h.Write(templates.Get("params.go.mustache"))
+  return hex.EncodeToString(h.Sum(nil))
+}
+
+// sourceHash hashes the Go files under root, skipping hidden directories like
+// the generated one, along with go.mod and go.sum and, withViews, the views.
+func sourceHash(root string, withViews bool) (string, error) {
+  files := make([]string, 0)
+  err := filepath.Walk(root, func(path string, fi os.FileInfo, err error) error {
+    if err != nil {
+      return err
+    }
+    if fi.IsDir() {
+      if path != root && strings.HasPrefix(fi.Name(), ".") {
+        return filepath.SkipDir
+      }
+      return nil
+    }
+    rel, _ := filepath.Rel(root, path)
+    if needsBuild(path) || (withViews && views.IsFile(rel)) {
+      files = append(files, path)
+    }
+    return nil
+  })
+  if err != nil {
+    return "", err
+  }
+  sort.Strings(files)
+  h := sha256.New()
+  for _, f := range files {
+    rel, _ := filepath.Rel(root, f)
+    fmt.Fprintf(h, "%v\x00", filepath.ToSlash(rel))
+    file, err := os.Open(f)
+    if err != nil {
+      return "", err
+    }
+    _, err = io.Copy(h, file)
+    file.Close()
+    if err != nil {
+      return "", err
+    }
+    h.Write([]byte{0})
+  }
+  return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,117 @@
+package proxy
+
+import (
+  "errors"
+  "fmt"
+  "io/ioutil"
+  "os/exec"
+  "path/filepath"
+  "runtime/debug"
+  "strings"
+  "github.com/murz/eg/logger"
+)
+
+// Builder compiles the generated server package in dir, inside the app at
+// root, into bin. On failure the error holds the compiler output.
+type Builder interface {
+  Build(root string, dir string, bin string) error
+}
+
+// Launcher starts the app binary with the given args and environment.
+type Launcher interface {
+  Launch(bin string, args []string, env []string) (Process, error)
+}
+
+// Process is an app binary started by a Launcher.
+type Process interface {
+  Wait() error
+  Kill() error
+}
+
+// GoBuilder builds with `go build`, from inside the app's module so the app's
+// own packages resolve through its go.mod.
+type GoBuilder struct{}
+
+func (GoBuilder) Build(root string, dir string, bin string) error {
+  rel, err := filepath.Rel(root, dir)
+  if err != nil {
+    return err
+  }
+  cmd := exec.Command("go", "build", "-o", bin, "./" + filepath.ToSlash(rel))
+  cmd.Dir = root
+  out, err := cmd.CombinedOutput()
+  if err != nil {
+    if len(out) == 0 {
+      return err
+    }
+    return errors.New(strings.TrimSpace(string(out)))
+  }
+  return nil
+}
+
+// Require adds the modules, given as path@version, that the go.mod in root
+// doesn't require yet to it, for generated helpers that import them.
+func Require(root string, modules ...string) error {
+  gomod, err := ioutil.ReadFile(filepath.Join(root, "go.mod"))
+  if err != nil {
+    return fmt.Errorf("the app needs a go.mod: %v", err)
+  }
+  missing := make([]string, 0)
+  for _, m := range modules {
+    path := strings.Split(m, "@")[0]
+    if !strings.Contains(string(gomod), path + " ") {
+      missing = append(missing, m)
+    }
+  }
+  if len(missing) == 0 {
+    return nil
+  }
+  buildLog.Infof("adding %v to go.mod", strings.Join(missing, ", "))
+  cmd := exec.Command("go", append([]string{"get"}, missing...)...)
+  cmd.Dir = root
+  if out, err := cmd.CombinedOutput(); err != nil {
+    return fmt.Errorf("go get: %v\n%s", err, out)
+  }
+  return nil
+}
+
+// Module is eg's own module. Generated code imports its runtime packages, like
+// github.com/murz/eg/params, so apps require it next to ego.
+const Module = "github.com/murz/eg"
+
+// ModuleVersion returns the version of Module apps should require: the one eg
+// was installed at, or latest for a development build of eg.
+func ModuleVersion() string {
+  if info, ok := debug.ReadBuildInfo(); ok && info.Main.Path == Module && strings.HasPrefix(info.Main.Version, "v") {
+    return info.Main.Version
+  }
+  return "latest"
+}
+
+// ExecLauncher runs the app as a child process whose stdout and stderr lines
+// are logged under "app", stderr's as warnings. A nil env inherits eg's
+// environment.
+type ExecLauncher struct{}
+
+func (ExecLauncher) Launch(bin string, args []string, env []string) (Process, error) {
+  cmd := exec.Command(bin, args...)
+  cmd.Env = env
+  cmd.Stdout = appLog.Writer(logger.Info, "stdout")
+  cmd.Stderr = appLog.Writer(logger.Warn, "stderr")
+  if err := cmd.Start(); err != nil {
+    return nil, err
+  }
+  return &execProcess{cmd}, nil
+}
+
+type execProcess struct {
+  cmd *exec.Cmd
+}
+
+func (e *execProcess) Wait() error {
+  return e.cmd.Wait()
+}
+
+func (e *execProcess) Kill() error {
+  return e.cmd.Process.Kill()
+}
@@ -4,7 +4,9 @@
 package proxy
 
 import (
-  "log"
+  "crypto/tls"
+  "encoding/json"
+  "html"
   "net/http"
   "net/http/httputil"
   "net/url"
@@ -12,23 +14,53 @@ import (
   "os"
   "os/exec"
   "path"
+  "path/filepath"
   "strings"
   "fmt"
   "io"
   "strconv"
+  "sync"
   "io/ioutil"
   "github.com/howeyc/fsnotify"
   "github.com/hoisie/mustache"
+  "github.com/murz/eg/config"
+  "github.com/murz/eg/db"
+  "github.com/murz/eg/dotenv"
+  "github.com/murz/eg/faults"
+  "github.com/murz/eg/har"
+  "github.com/murz/eg/mocks"
   "github.com/murz/eg/templates"
+  "github.com/murz/eg/testrunner"
   "github.com/murz/eg/inspector"
-  "bytes"
+  "github.com/murz/eg/logger"
+  "github.com/murz/eg/views"
   "regexp"
 )
 
 type Proxy struct {
+  Builder Builder
+  Launcher Launcher
+  root string
   dir string
   binPath string
-  cmd []*exec.Cmd
+  cmd []Process
+  env []string
+  requests *requestLog
+  recorder *har.Recorder
+  mocks []*mocks.Rule
+  mockLock sync.RWMutex
+  faults *faults.Set
+  buildLock sync.Mutex
+  timings Timings
+  // dev is set when the proxy runs the app, which then reads its views from
+  // disk instead of the copy embedded at build time.
+  dev bool
+  cert *tls.Certificate
+  redirect bool
+  // host is the interface the proxy listens on. It's the loopback one unless
+  // Listen says otherwise, as /_eg/requests shows the cookies and bodies of
+  // every request and /_eg/faults changes how the app answers.
+  host string
   ln net.Listener
   conn net.Conn
 }
@@ -46,16 +78,26 @@ func Run() {
 }
 
 func (p *Proxy) initialize() {
-  p.cmd = make([]*exec.Cmd, 0)
+  p.Builder = GoBuilder{}
+  p.Launcher = ExecLauncher{}
+  p.cmd = make([]Process, 0)
+  p.requests = newRequestLog()
+  p.faults = faults.NewSet()
+  p.host = "127.0.0.1"
 }
 
-var errRegexp, _ = regexp.Compile("(.+go):([0-9]+):[0-9]{0,}:? (.+)")
+var errRegexp, _ = regexp.Compile("(.+(?:go|html)):([0-9]+):[0-9]{0,}:? (.+)")
+
+var (
+  buildLog = logger.New("build")
+  watchLog = logger.New("watch")
+  appLog = logger.New("app")
+  proxyLog = logger.New("proxy")
+)
 
 func checkErr(err error) {
   if err != nil {
-    log.Printf("ERR?")
-    log.Printf("%s", err)
-    log.Printf("...........")
+    proxyLog.Errorf("%v", err)
   }
 }
 
@@ -63,12 +105,12 @@ func watchAll(watcher *fsnotify.Watcher, dirname string) {
 //  watcher.Watch(dirname)
   dirlist, err := ioutil.ReadDir(dirname)
   if err != nil {
-    log.Fatalf("Error reading %s: %s\n", dirname, err)
+    watchLog.Fatalf("Error reading %s: %s", dirname, err)
   }
   for _, f := range dirlist {
     filename := path.Join(dirname, f.Name())
     if f.IsDir() {
-      log.Printf("watching: %v", filename)
+      watchLog.Debugf("watching %v", filename)
       err = watcher.Watch(filename)
       checkErr(err)
       watchAll(watcher, filename)
@@ -76,18 +118,35 @@ func watchAll(watcher *fsnotify.Watcher, dirname string) {
   }
 }
 
+// Watch returns a watcher on dirs and all of their subdirectories. Dirs that
+// don't exist are skipped.
+func Watch(dirs ...string) (*fsnotify.Watcher, error) {
+  watcher, err := fsnotify.NewWatcher()
+  if err != nil {
+    return nil, err
+  }
+  for _, dir := range dirs {
+    if fi, err := os.Stat(dir); err != nil || !fi.IsDir() {
+      continue
+    }
+    if err := watcher.Watch(dir); err != nil {
+      return nil, err
+    }
+    watchAll(watcher, dir)
+  }
+  return watcher, nil
+}
+
 func (p *Proxy) handleErr(err string) {
-  log.Printf("ERRRRRR: %v", err)
+  buildLog.Errorf("%v", err)
     if errRegexp.MatchString(err) {
       pieces := errRegexp.FindStringSubmatch(err)
-      log.Printf("%v", pieces)
       p.startErr(&ErrorHandler{
         Filename: pieces[1],
         Message: pieces[3],
         Line: pieces[2],
       })
     } else {
-      fmt.Println("starting err..")
       p.startErr(&ErrorHandler{
         Filename: "",
         Message: err,
@@ -96,59 +155,86 @@ func (p *Proxy) handleErr(err string) {
     }
 }
 
-func (p *Proxy) compile() bool {
+// compile runs build and shows the error page if it fails.
+func (p *Proxy) compile(build func() error) bool {
   
   defer func() {
       if r := recover(); r != nil {
-        fmt.Println("recover COMPILE")
-        fmt.Println("recover %v", r)
           p.handleErr(fmt.Sprintf("%v", r))
       }
   }()
 
-  log.Printf("compiling to: %v", p.binPath)
-  log.Printf("go build -o %s %s", p.binPath, p.dir + "/server.go")
-  cmd := exec.Command("go", "build", "-o", p.binPath, p.dir + "/server.go")
-  log.Printf("AFTER EXEC COMMAND???")
-  var buf bytes.Buffer
-  stdout, err := cmd.StdoutPipe()
-  checkErr(err)
-  go io.Copy(&buf, stdout)
-  // go io.Copy(os.Stderr, stderr)
-  
-  err = cmd.Start()
-  checkErr(err)
-
-  // log.Printf("removing... %s", p.dir)
-  // os.RemoveAll(p.dir)
-  log.Printf("waitin..")
-  err = cmd.Wait()
+  err := build()
   if err != nil {
-    lines := strings.Split(buf.String(), "\n")
-    if len(lines) >= 2 {
-      p.handleErr(fmt.Sprintf("%v", lines[1]))
-      return false
+    lines := strings.Split(err.Error(), "\n")
+    msg := lines[0]
+    for _, line := range lines {
+      if errRegexp.MatchString(line) {
+        msg = line
+        break
+      }
     }
+    p.handleErr(msg)
+    return false
   }
   return true
-  // if err != nil {
-  //   p.startErr(&ErrorHandler{
-  //     Filename: "",
-  //     Message: "BLAH!",
-  //     Line: "",
-  //   })
-  // }
-    
 }
 
-func (p *Proxy) setupErrDir(e *ErrorHandler) {
+// build compiles the generated package in p.dir. On failure the error holds
+// the compiler output.
+func (p *Proxy) build() error {
+  buildLog.Debugf("compiling to %v", p.binPath)
+  return p.Builder.Build(p.root, p.dir, p.binPath)
+}
 
-  wd, _ := os.Getwd()
-//  dirs := strings.Split(wd, "/")
- // curDir := dirs[len(dirs) - 1]
+// Build inspects the app in the working directory and compiles it, returning
+// the path of the binary.
+func Build() (string, error) {
+  return defaultProxy.Build()
+}
 
+func (p *Proxy) Build() (string, error) {
+  t, err := p.plan()
+  if err != nil {
+    return p.binPath, err
+  }
+  buildLog.Infof("%v", t)
+  return p.binPath, p.precompileErrors()
+}
+
+// precompileErrors has the built app render its error pages into public/, the
+// static pages for when it runs without eg's dev handler in front of it.
+func (p *Proxy) precompileErrors() error {
+  if len(inspector.GetErrorPages()) == 0 {
+    return nil
+  }
+  cmd := exec.Command(p.binPath, "-precompile-errors", "public")
+  cmd.Dir = p.root
+  if out, err := cmd.CombinedOutput(); err != nil {
+    return fmt.Errorf("precompiling the error pages: %v\n%s", err, out)
+  }
+  buildLog.Infof("precompiled %v error pages into public/", len(inspector.GetErrorPages()))
+  return nil
+}
+
+// Generate inspects the app in the working directory and writes the generated
+// server code, without compiling it.
+func Generate() {
+  defaultProxy.Generate()
+}
+
+func (p *Proxy) Generate() {
+  inspector.InitActions()
+  inspector.Inspect()
+  p.setupDir()
+}
+
+func (p *Proxy) setupErrDir(e *ErrorHandler) {
+
+  // The error server is the only file of its package, so what the last
+  // generate wrote goes, and with it build.json.
   root := ".ego-genfiles"
-  os.Remove(root)
+  os.RemoveAll(root)
   os.MkdirAll(root, 0777)
 
   file, _ := ioutil.ReadFile(e.Filename)
@@ -169,7 +255,7 @@ func (p *Proxy) setupErrDir(e *ErrorHandler) {
   }
   for i := min; i < max; i++ {
     num := i+1 
-    value := lines[i]
+    value := html.EscapeString(lines[i])
     if num == line {
       code += "<li class='err'>"+value+"</li>"
     } else {
@@ -178,43 +264,169 @@ func (p *Proxy) setupErrDir(e *ErrorHandler) {
   }
   code += "</ol>"
 
-  server := mustache.Render(string(templates.ErrServer()), map[string]interface{} {
+  server := mustache.Render(string(templates.Get("errserver.go.mustache")), map[string]interface{} {
     "Message": e.Message,
     "Filename": e.Filename,
     "Line": e.Line,
     "Code": code,
-    "Port": ":5000",
+    "Port": fmt.Sprintf(":%v", config.Current().Port),
   })
   serverFile, _ := os.Create(root+"/server.go")
-  log.Printf("writing: %v", server)
   serverFile.Write([]byte(server))
+  serverFile.Close()
 
-  p.dir = path.Join(wd, root);
-  p.binPath = path.Join(wd, root, "ego-server")
+  p.setPaths()
 }
 
 func (p *Proxy) setupDir() {
+  p.setPaths()
+  p.writeServer(p.serverData())
+  vs, err := views.Discover(views.Dir)
+  checkErr(err)
+  checkErr(p.copyViews(vs))
+}
 
+// setPaths points the proxy at the generated package of the app in the
+// working directory.
+func (p *Proxy) setPaths() {
   wd, _ := os.Getwd()
-  dirs := strings.Split(wd, "/")
-  curDir := dirs[len(dirs) - 1]
-
   root := ".ego-genfiles"
-  os.Remove(root)
-  os.MkdirAll(root, 0777)
+  p.root = wd
+  p.dir = path.Join(wd, root);
+  p.binPath = path.Join(wd, root, "ego-server")
+}
 
-  server := mustache.Render(string(templates.Server()), map[string]interface{} {
+// serverData is what server.go is rendered from.
+func (p *Proxy) serverData() map[string]interface{} {
+  wd, _ := os.Getwd()
+  dirs := strings.Split(wd, "/")
+  curDir := dirs[len(dirs) - 1]
+  data := map[string]interface{} {
     "Name": curDir,
+    "Module": inspector.GetModule(),
+    "ActionHeader": ActionHeader,
     "Actions": inspector.GetActions(),
     "HasActions": (len(inspector.GetActions()) > 0),
-  })
-  serverFile, _ := os.Create(root+"/server.go")
-  log.Printf("writing: %v", server)
+    "HasViews": hasViews(),
+    "HasHelpers": (len(inspector.GetHelpers()) > 0),
+    "HasFuncMap": hasFuncMap(),
+    "Helpers": inspector.GetHelpers(),
+    "HTMLErrorPages": errorPages("html"),
+    "JSONErrorPages": jsonErrorPages(),
+    "HasErrorPages": (len(inspector.GetErrorPages()) > 0),
+    "Routes": routeLiterals(),
+    "Drivers": dbDrivers(),
+  }
+  for k, v := range binders() {
+    data[k] = v
+  }
+  return data
+}
+
+// routeLiterals returns the routes of conf/routes.go as the Go literals of
+// handler.go's route table.
+func routeLiterals() []map[string]string {
+  list := make([]map[string]string, 0)
+  for _, r := range inspector.GetRoutes() {
+    list = append(list, map[string]string{
+      "Literal": fmt.Sprintf("{%q, %q, %q}", r.Method, r.Path, r.Action),
+    })
+  }
+  return list
+}
+
+// dbDrivers returns the drivers of the configured databases, which the server
+// imports, as package db doesn't.
+func dbDrivers() []*db.Driver {
+  names, err := config.Drivers()
+  if err != nil {
+    buildLog.Warnf("%v", err)
+  }
+  drivers := make([]*db.Driver, 0)
+  for _, name := range names {
+    d, err := db.Lookup(name)
+    if err != nil {
+      buildLog.Warnf("%v", err)
+      continue
+    }
+    drivers = append(drivers, d)
+  }
+  return drivers
+}
+
+// errorPages returns the error pages in format.
+func errorPages(format string) []*inspector.ErrorPage {
+  pages := make([]*inspector.ErrorPage, 0)
+  for _, e := range inspector.GetErrorPages() {
+    if e.Format == format {
+      pages = append(pages, e)
+    }
+  }
+  return pages
+}
+
+// jsonErrorPages returns the JSON error pages with their text as a Go
+// literal, which errors.go keeps in the binary.
+func jsonErrorPages() []map[string]interface{} {
+  list := make([]map[string]interface{}, 0)
+  for _, e := range errorPages("json") {
+    text, _ := ioutil.ReadFile(filepath.Join(views.Dir, e.View + ".json"))
+    list = append(list, map[string]interface{}{
+      "Status": e.Status,
+      "Literal": strconv.Quote(string(text)),
+    })
+  }
+  return list
+}
+
+// hasFuncMap reports whether the helpers package declares a Funcs map.
+func hasFuncMap() bool {
+  for _, h := range inspector.GetHelpers() {
+    if h.Func == "" {
+      return true
+    }
+  }
+  return false
+}
+
+func hasViews() bool {
+  vs, _ := views.Discover(views.Dir)
+  return len(vs) > 0
+}
+
+// writeServer renders server.go and handler.go, which serves the app in front
+// of the ego server, and for apps with views, views.go, which embeds the copy
+// of app/views made by copyViews. errors.go renders the error pages and
+// params.go checks the parameters of the actions.
+func (p *Proxy) writeServer(data map[string]interface{}) {
+  os.MkdirAll(p.dir, 0777)
+  server := mustache.Render(string(templates.Get("server.go.mustache")), data)
+  serverFile, _ := os.Create(path.Join(p.dir, "server.go"))
   serverFile.Write([]byte(server))
-  
+  serverFile.Close()
+  p.writeGenFile("handler.go", true, data)
+  p.writeGenFile("views.go", data["HasViews"] == true, data)
+  p.writeGenFile("errors.go", data["HasErrorPages"] == true, data)
+  p.writeGenFile("params.go", data["HasBinders"] == true, data)
+}
 
-  p.dir = path.Join(wd, root);
-  p.binPath = path.Join(wd, root, "ego-server")
+// writeGenFile renders the template for filename into the generated package
+// if the app needs it, and removes the file otherwise.
+func (p *Proxy) writeGenFile(filename string, needed bool, data map[string]interface{}) {
+  if !needed {
+    os.Remove(path.Join(p.dir, filename))
+    return
+  }
+  code := mustache.Render(string(templates.Get(filename + ".mustache")), data)
+  ioutil.WriteFile(path.Join(p.dir, filename), []byte(code), 0666)
+}
+
+// copyViews copies the views into the generated package to be embedded.
+func (p *Proxy) copyViews(vs []views.View) error {
+  if len(vs) == 0 {
+    return os.RemoveAll(path.Join(p.dir, "views"))
+  }
+  return views.Copy(vs, path.Join(p.dir, "views"))
 }
 
 type ErrorHandler struct {
@@ -225,141 +437,331 @@ type ErrorHandler struct {
 
 func (p *Proxy) startErr(e *ErrorHandler) {
   p.stop()
-  fmt.Println("starting err!")
   p.setupErrDir(e)
-  ok := p.compile()
-  if !ok {
-    fmt.Printf("NOT OK")
+  // Showing the error server's own build error would loop forever.
+  if err := p.build(); err != nil {
+    buildLog.Errorf("the error server didn't build: %v", err)
     return
   }
-  log.Printf("running...")
-  cmd := exec.Command(p.binPath, "-dev=true", fmt.Sprintf("-port=%v", /*p.flags["port"]*/ 5000))
-  fmt.Printf("STARTING ERR: %v", cmd)
-  p.cmd = append(p.cmd, cmd)
-  fmt.Printf("APPENDING.. %v", len(p.cmd))
-  stdout, err := cmd.StdoutPipe()
-  checkErr(err)
-  stderr, err := cmd.StderrPipe()
-  checkErr(err)
-  go io.Copy(os.Stdout, stdout)
-  go io.Copy(os.Stderr, stderr)
-  fmt.Print("start err")
-  err = cmd.Start()
-  checkErr(err)
-  // log.Printf("removing... %s", p.dir)
+  appLog.Infof("showing the error page")
+  proc, err := p.Launcher.Launch(p.binPath, p.args(), nil)
+  if err != nil {
+    appLog.Errorf("%v", err)
+    return
+  }
+  p.cmd = append(p.cmd, proc)
   // os.RemoveAll(p.dir)
-  err = cmd.Wait()
+  // startErr runs in the middle of a restart, so don't hold it up.
+  go proc.Wait()
+}
 
+// args returns the command line the app binary is started with.
+func (p *Proxy) args() []string {
+  return []string{"-dev=true", fmt.Sprintf("-port=%v", config.Current().Port)}
 }
 
+// start builds what changed and (re)starts the app, waiting until it exits.
+// If nothing needed compiling, a running app is left alone.
 func (p *Proxy) start() {
-  p.stop()
-  fmt.Println("starting!!...")
+  if proc := p.restart(); proc != nil {
+    proc.Wait()
+  }
+}
+
+// restart does the work of start. Watch events come in bursts, so only one
+// restart runs at a time.
+func (p *Proxy) restart() (proc Process) {
+  p.buildLock.Lock()
+  defer p.buildLock.Unlock()
   defer func() {
       if r := recover(); r != nil {
-        fmt.Println("recover START")
         p.handleErr(fmt.Sprintf("%v", r))
+        proc = nil
       }
   }()
-  inspector.InitActions()
-  inspector.Inspect()
-  p.setupDir()
-  ok := p.compile()
+  var t Timings
+  ok := p.compile(func() error {
+    var err error
+    t, err = p.plan()
+    return err
+  })
   if !ok {
-    fmt.Printf("NOT OK")
-    return
+    return nil
   }
-  log.Printf("running...")
-  cmd := exec.Command(p.binPath, "-dev=true", fmt.Sprintf("-port=%v", /*p.flags["port"]*/ 5000))
-  p.cmd = append(p.cmd, cmd)
-  fmt.Printf("APPENDING... %v (from start)", len(p.cmd))
-  stdout, err := cmd.StdoutPipe()
-  checkErr(err)
-  stderr, err := cmd.StderrPipe()
-  checkErr(err)
-  go io.Copy(os.Stdout, stdout)
-  go io.Copy(os.Stderr, stderr)
-  fmt.Print("start dev")
-  err = cmd.Start()
+  buildLog.Infof("%v", t)
+  if !t.Compiled && len(p.cmd) > 0 {
+    appLog.Debugf("nothing to rebuild, the app keeps running")
+    return nil
+  }
+  p.stop()
+  p.loadEnv()
+  appLog.Infof("starting on port %v", config.Current().Port)
+  proc, err := p.Launcher.Launch(p.binPath, p.args(), p.appEnv())
   if (err != nil) {
-    log.Printf("err: %v", err)
+    appLog.Errorf("%v", err)
+    return nil
   }
-  checkErr(err)
-  // log.Printf("removing... %s", p.dir)
+  p.cmd = append(p.cmd, proc)
   // os.RemoveAll(p.dir)
-  err = cmd.Wait()
+  return proc
+}
+
+// appEnv is the environment the app runs in: eg's own, the .env files,
+// EGO_ERROR_DETAILS, which has the error pages show the request and stack,
+// and the configured databases, all of them as JSON in EGO_DATABASES and the
+// primary one in EGO_DB_DRIVER and EGO_DB_DSN.
+func (p *Proxy) appEnv() []string {
+  env := append(os.Environ(), p.env...)
+  env = append(env, fmt.Sprintf("EGO_ERROR_DETAILS=%v", config.Current().ErrorDetails))
+  if dbs := config.Current().AllDBs(); len(dbs) > 0 {
+    data, _ := json.Marshal(dbs)
+    env = append(env, "EGO_DATABASES=" + string(data))
+  }
+  if db := config.Current().Database; db.Driver != "" {
+    env = append(env, "EGO_DB_DRIVER=" + db.Driver, "EGO_DB_DSN=" + db.DSN)
+  }
+  return env
+}
+
+// Env returns the environment eg runs the app in, for other commands that
+// run the app's code.
+func Env() []string {
+  defaultProxy.loadEnv()
+  return defaultProxy.appEnv()
+}
+
+// envFiles returns the .env files passed to the app, in load order.
+func envFiles() []string {
+  return append(dotenv.Files(config.Env()), config.Current().EnvFiles...)
+}
+
+// loadEnv reads the .env files into p.env, reporting where each key came from.
+// Variables already set in eg's own environment win over the files.
+func (p *Proxy) loadEnv() {
+  vars, err := dotenv.Load(envFiles())
+  if err != nil {
+    appLog.Errorf("env: %v", err)
+    return
+  }
+  p.env = make([]string, 0)
+  files := make([]string, 0)
+  keys := make(map[string][]string)
+  for _, v := range vars {
+    if _, ok := os.LookupEnv(v.Key); ok {
+      appLog.Debugf("env: %v from %v is ignored, it's set in the environment", v.Key, v.File)
+      continue
+    }
+    appLog.Debugf("env: %v=%v (%v)", v.Key, dotenv.Mask(v.Value), v.File)
+    p.env = append(p.env, v.Key + "=" + v.Value)
+    if _, ok := keys[v.File]; !ok {
+      files = append(files, v.File)
+    }
+    keys[v.File] = append(keys[v.File], v.Key)
+  }
+  // The values can be secrets, so only -v shows them, masked.
+  for _, f := range files {
+    appLog.Infof("env: %v from %v", strings.Join(keys[f], ", "), f)
+  }
+}
+
+// reloadEnv restarts the app so it gets the .env files as they are now. The
+// environment is only read at launch, so the app is stopped even when nothing
+// needs rebuilding. Like start, it waits until the app exits.
+func (p *Proxy) reloadEnv() {
+  p.buildLock.Lock()
+  p.stop()
+  p.buildLock.Unlock()
+  p.start()
 }
 
 func (p *Proxy) stop() {
-  fmt.Println("killin..")
-  log.Printf("%v", len(p.cmd))
   if len(p.cmd) > 0 {
-    for _, cmd := range p.cmd {
-      cmd.Process.Kill()
+    appLog.Debugf("stopping")
+    for _, proc := range p.cmd {
+      proc.Kill()
     }
-    p.cmd = make([]*exec.Cmd, 0)
+    p.cmd = make([]Process, 0)
   }
-    fmt.Println("after killin dev")
 }
 
 func (p *Proxy) run() {
 
   go p.start()
 
-  watcher, err := fsnotify.NewWatcher()
+  watcher, err := Watch(append(config.Current().Assets, config.Current().Watch...)...)
   checkErr(err)
-//  err = watcher.Watch(".")
-//  checkErr(err)
-  fmt.Println("watching")
-  /*watchAll(watcher, "app/assets/javascripts")
-  watchAll(watcher, "app/assets/stylesheets")
-  watchAll(watcher, "app/assets/images")
-  watchAll(watcher, "app/controllers")
-  watchAll(watcher, "conf")*/
-  // watchAll(watcher, ".")
-
-  watcher.Watch("app/assets/javascripts")
-  watcher.Watch("app/assets/stylesheets")
-  watcher.Watch("app/assets/images")
-  watcher.Watch("app/controllers")
-  watcher.Watch("conf")
+  // .env files are usually in the app root, so only watch their directories
+  // themselves.
+  envDirs := make(map[string]bool)
+  for _, f := range envFiles() {
+    envDirs[filepath.Dir(f)] = true
+  }
+  for dir := range envDirs {
+    watcher.Watch(dir)
+  }
 
   for {
       select {
       case evt := <-watcher.Event:
-        if strings.HasPrefix(evt.Name, "app/assets") {
-          if strings.HasSuffix(evt.Name, ".js") {
-            fmt.Println("evt: ", evt.String())
-            fmt.Println("should recompile: ", evt.Name)
+        if isAsset(evt.Name) {
+          watchLog.Debugf("%v changed", evt.Name)
+        } else if mocks.IsFile(evt.Name) {
+          watchLog.Infof("%v changed, reloading the mocks", evt.Name)
+          p.loadMocks()
+        } else if filepath.Clean(evt.Name) == filepath.Clean(mocks.Dir) && evt.IsCreate() {
+          watcher.Watch(mocks.Dir)
+          p.loadMocks()
+        } else if views.IsFile(evt.Name) {
+          // The app reads views from disk, but they're checked again so a
+          // broken one shows up on the error page.
+          watchLog.Debugf("%v changed, checking the views", evt.Name)
+          go p.start()
+        } else if dotenv.IsFile(evt.Name, envFiles()) {
+          watchLog.Infof("%v changed, restarting with the new environment", evt.Name)
+          go p.reloadEnv()
+        } else if isWatched(evt.Name) {
+          if !needsBuild(evt.Name) {
+            watchLog.Debugf("%v changed, the app reloads it", evt.Name)
+            continue
           }
-        } else {
-          // Restart
-          log.Print("EVENT!!!")
-          p.stop()
-          fmt.Println("restartin")
+          watchLog.Infof("%v changed, rebuilding", evt.Name)
           go p.start()
         }
       case err := <-watcher.Error:
-          log.Println("error:", err)
+          watchLog.Errorf("%v", err)
       }
   }
 }
 
+// isWatched reports whether a changed file is in one of the directories that
+// restart the app. Other files show up when a .env file's directory is watched.
+func isWatched(filename string) bool {
+  filename = filepath.Clean(filename)
+  for _, dir := range config.Current().Watch {
+    if strings.HasPrefix(filename, filepath.Clean(dir)) {
+      return true
+    }
+  }
+  return false
+}
+
+// isAsset reports whether a changed file is in one of the asset directories,
+// which are picked up without restarting the app.
+func isAsset(filename string) bool {
+  for _, dir := range config.Current().Assets {
+    if strings.HasPrefix(filename, dir) {
+      return true
+    }
+  }
+  return false
+}
+
 func (p *Proxy) Run() {
 
+  p.dev = true
   go p.run()
 
-  u, err := url.Parse("http://localhost:5000")
+  u, err := url.Parse(fmt.Sprintf("http://localhost:%v", config.Current().Port))
   if err != nil {
-    log.Fatal(err)
+    proxyLog.Fatalf("%v", err)
   }
 
   reverse_proxy := httputil.NewSingleHostReverseProxy(u)
-  http.Handle("/", reverse_proxy)
+  director := reverse_proxy.Director
+  reverse_proxy.Director = func(r *http.Request) {
+    director(r)
+    if r.TLS != nil {
+      r.Header.Set("X-Forwarded-Proto", "https")
+    }
+  }
+  reverse_proxy.ModifyResponse = p.modifyResponse
+  p.loadMocks()
+  http.HandleFunc("/_eg/tests", p.serveTests)
+  http.HandleFunc("/_eg/requests", p.serveRequests)
+  http.HandleFunc("/_eg/requests/", p.serveRequests)
+  http.HandleFunc("/_eg/faults", p.serveFaults)
+  http.HandleFunc("/_eg/faults.json", p.serveFaultsJSON)
+  http.Handle("/", p.record(p.fault(p.mock(reverse_proxy))))
+
+  var handler http.Handler
+  if p.cert != nil {
+    go p.serveTLS()
+    if p.redirect {
+      handler = http.HandlerFunc(redirectHTTPS)
+    }
+  }
+
+  proxyLog.Infof("listening on http://%v", p.addr(config.Current().ProxyPort))
+  if err = http.ListenAndServe(p.addr(config.Current().ProxyPort), handler); err != nil {
+    proxyLog.Fatalf("%v", err)
+  }
+}
 
-  log.Println("Server started")
-  if err = http.ListenAndServe(":5050", nil); err != nil {
-    log.Fatal(err)
+// Listen makes Run listen on host instead of the loopback interface, which
+// lets other machines use the proxy and its /_eg pages. "" is every interface.
+func Listen(host string) {
+  defaultProxy.Listen(host)
+}
+
+func (p *Proxy) Listen(host string) {
+  p.host = host
+}
+
+// addr is where the proxy listens for port.
+func (p *Proxy) addr(port int) string {
+  return net.JoinHostPort(p.host, strconv.Itoa(port))
+}
+
+// ServeHTTPS makes Run serve TLS with cert on the https_port as well. With
+// redirect, plain HTTP requests are sent there instead of to the app.
+func ServeHTTPS(cert tls.Certificate, redirect bool) {
+  defaultProxy.ServeHTTPS(cert, redirect)
+}
+
+func (p *Proxy) ServeHTTPS(cert tls.Certificate, redirect bool) {
+  p.cert = &cert
+  p.redirect = redirect
+}
+
+// serveTLS serves the same handlers as Run over TLS, with HTTP/2.
+func (p *Proxy) serveTLS() {
+  srv := &http.Server{
+    Addr: p.addr(config.Current().HTTPSPort),
+    TLSConfig: &tls.Config{
+      Certificates: []tls.Certificate{*p.cert},
+      NextProtos: []string{"h2", "http/1.1"},
+    },
+  }
+  proxyLog.Infof("listening on https://%v", p.addr(config.Current().HTTPSPort))
+  if err := srv.ListenAndServeTLS("", ""); err != nil {
+    proxyLog.Fatalf("%v", err)
   }
 }
 
+func redirectHTTPS(w http.ResponseWriter, r *http.Request) {
+  host, _, err := net.SplitHostPort(r.Host)
+  if err != nil {
+    host = r.Host
+  }
+  target := fmt.Sprintf("https://%v%v", net.JoinHostPort(host, strconv.Itoa(config.Current().HTTPSPort)), r.URL.RequestURI())
+  http.Redirect(w, r, target, http.StatusTemporaryRedirect)
+}
+
+
+
+// serveTests shows the latest `eg test` report.
+func (p *Proxy) serveTests(w http.ResponseWriter, r *http.Request) {
+  report, err := testrunner.LoadReport()
+  if err != nil {
+    http.Error(w, "No test results yet. Run `eg test` to see them here.", http.StatusNotFound)
+    return
+  }
+  page := mustache.Render(string(templates.Get("tests.html.mustache")), map[string]interface{} {
+    "Running": report.Running,
+    "Passed": report.Passed(),
+    "Started": report.Started.Format("15:04:05"),
+    "Packages": report.Packages,
+  })
+  w.Header().Set("Content-Type", "text/html; charset=utf-8")
+  io.WriteString(w, page)
+}
\ No newline at end of file
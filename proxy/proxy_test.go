@@ -0,0 +1,718 @@
+package proxy
+
+import (
+  "bytes"
+  "errors"
+  "fmt"
+  "io/ioutil"
+  "net"
+  "net/http"
+  "net/http/httptest"
+  "net/http/httputil"
+  "net/url"
+  "os"
+  "os/exec"
+  "path/filepath"
+  "strconv"
+  "strings"
+  "testing"
+  "time"
+  "github.com/murz/eg/config"
+  "github.com/murz/eg/har"
+  "github.com/murz/eg/logger"
+)
+
+type fakeBuilder struct {
+  errs []error
+  dirs []string
+}
+
+func (b *fakeBuilder) Build(root string, dir string, bin string) error {
+  b.dirs = append(b.dirs, dir)
+  if len(b.errs) > 0 {
+    err := b.errs[0]
+    b.errs = b.errs[1:]
+    return err
+  }
+  return ioutil.WriteFile(bin, []byte("binary"), 0777)
+}
+
+type fakeLauncher struct {
+  args [][]string
+  envs [][]string
+}
+
+func (l *fakeLauncher) Launch(bin string, args []string, env []string) (Process, error) {
+  l.args = append(l.args, args)
+  l.envs = append(l.envs, env)
+  return &fakeProcess{}, nil
+}
+
+// fakeProcess exits as soon as it's waited on.
+type fakeProcess struct {
+  killed bool
+}
+
+func (p *fakeProcess) Wait() error {
+  return nil
+}
+
+func (p *fakeProcess) Kill() error {
+  p.killed = true
+  return nil
+}
+
+// setupApp creates a small app in a temporary directory and changes into it
+// until the end of the test.
+func setupApp(t *testing.T) (*Proxy, *fakeBuilder, *fakeLauncher) {
+  dir := t.TempDir()
+  files := map[string]string{
+    "go.mod": "module example.com/demo\n\nrequire (\n\tgithub.com/murz/ego v0.1.0\n\tgithub.com/murz/eg v0.1.0\n)\n",
+    "conf/routes.go": "package conf\n\nfunc Routes() {}\n",
+    "app/controllers/posts_controller.go": "package controllers\n\ntype PostsController struct{}\n\nfunc (c PostsController) Index() {}\n",
+  }
+  for name, content := range files {
+    filename := filepath.Join(dir, name)
+    os.MkdirAll(filepath.Dir(filename), 0777)
+    if err := ioutil.WriteFile(filename, []byte(content), 0666); err != nil {
+      t.Fatal(err)
+    }
+  }
+  wd, _ := os.Getwd()
+  if err := os.Chdir(dir); err != nil {
+    t.Fatal(err)
+  }
+  t.Cleanup(func() {
+    os.Chdir(wd)
+  })
+
+  b := &fakeBuilder{}
+  l := &fakeLauncher{}
+  p := NewProxy()
+  p.Builder = b
+  p.Launcher = l
+  return p, b, l
+}
+
+func readServer(t *testing.T) string {
+  server, err := ioutil.ReadFile(".ego-genfiles/server.go")
+  if err != nil {
+    t.Fatal(err)
+  }
+  return string(server)
+}
+
+func TestStartBuildsAndLaunchesApp(t *testing.T) {
+  p, b, l := setupApp(t)
+  ioutil.WriteFile(".env", []byte("EG_PROXY_TEST_SECRET=shh\n"), 0666)
+  var logged bytes.Buffer
+  logger.SetOutput(&logged)
+  defer logger.SetOutput(os.Stderr)
+
+  p.start()
+
+  if len(b.dirs) != 1 || filepath.Base(b.dirs[0]) != ".ego-genfiles" {
+    t.Fatalf("built %v, want just .ego-genfiles", b.dirs)
+  }
+  server := readServer(t)
+  for _, want := range []string{`"example.com/demo/app/controllers"`, `"PostsController.Index"`} {
+    if !strings.Contains(server, want) {
+      t.Errorf("server.go doesn't contain %v:\n%v", want, server)
+    }
+  }
+  if len(l.args) != 1 {
+    t.Fatalf("launched %v times, want 1", len(l.args))
+  }
+  if strings.Join(l.args[0], " ") != "-dev=true -port=5000" {
+    t.Errorf("launched with %v", l.args[0])
+  }
+  found := false
+  for _, kv := range l.envs[0] {
+    found = found || kv == "EG_PROXY_TEST_SECRET=shh"
+  }
+  if !found {
+    t.Errorf("the app's environment doesn't include the .env file")
+  }
+  if !strings.Contains(logged.String(), "env: EG_PROXY_TEST_SECRET from .env") || strings.Contains(logged.String(), "shh") {
+    t.Errorf("logged %q, want the key and its file without the value", logged.String())
+  }
+}
+
+func TestViewsAndErrorPagesAreGenerated(t *testing.T) {
+  p, _, l := setupApp(t)
+  os.MkdirAll("app/views/errors", 0777)
+  ioutil.WriteFile("app/views/posts.html", []byte("<h1>{{.Title}}</h1>"), 0666)
+  ioutil.WriteFile("app/views/errors/500.html", []byte("<html><body>oops</body></html>"), 0666)
+
+  p.start()
+
+  for _, f := range []string{"views.go", "errors.go", "views/posts.html", "views/errors/500.html"} {
+    if _, err := os.Stat(filepath.Join(".ego-genfiles", f)); err != nil {
+      t.Errorf("%v wasn't generated", f)
+    }
+  }
+  errs, _ := ioutil.ReadFile(".ego-genfiles/errors.go")
+  if !strings.Contains(string(errs), `500: "errors/500",`) {
+    t.Errorf("errors.go doesn't register errors/500:\n%s", errs)
+  }
+  found := false
+  for _, kv := range l.envs[0] {
+    found = found || kv == "EGO_ERROR_DETAILS=true"
+  }
+  if !found {
+    t.Errorf("the app wasn't asked for error details")
+  }
+
+  // A broken view is reported with its file and line instead of compiling.
+  ioutil.WriteFile("app/views/posts.html", []byte("<h1>\n{{.Title | shout}}</h1>"), 0666)
+  p.start()
+  server := readServer(t)
+  if !strings.Contains(server, "app/views/posts.html") || !strings.Contains(server, "shout") || !strings.Contains(server, "&lt;h1&gt;") {
+    t.Errorf("error server doesn't show the view error:\n%v", server)
+  }
+}
+
+func TestParamsAreBoundByGeneratedCode(t *testing.T) {
+  p, _, _ := setupApp(t)
+  os.MkdirAll("app/models", 0777)
+  ioutil.WriteFile("app/models/post.go", []byte("package models\n\nimport \"time\"\n\ntype Post struct {\n\tTitle string `param:\",required\"`\n\tTags []string\n\tAuthor *Author `json:\"by\"`\n\tdraft bool\n\tAt time.Time\n}\n\ntype Author struct {\n\tName string\n}\n"), 0666)
+  ioutil.WriteFile("app/controllers/posts_controller.go", []byte("package controllers\n\nimport (\n\t\"time\"\n\t\"example.com/demo/app/models\"\n)\n\ntype PostsController struct{}\n\ntype Filter struct {\n\tSince time.Time\n}\n\nfunc (c PostsController) Index(page, per int, f Filter) {}\n\nfunc (c PostsController) Create(post *models.Post, ids []int64, draft *bool) {}\n\nfunc (c PostsController) Show(id int, ch chan int) {}\n\nfunc (c PostsController) New() {}\n"), 0666)
+
+  p.start()
+
+  code, err := ioutil.ReadFile(".ego-genfiles/params.go")
+  if err != nil {
+    t.Fatal(err)
+  }
+  for _, want := range []string{
+    `"example.com/demo/app/controllers"`,
+    `"example.com/demo/app/models"`,
+    `binders["PostsController.Index"] = params.Handler(bindPostsControllerIndex)`,
+    `binders["PostsController.Create"] = params.Handler(bindPostsControllerCreate)`,
+    `perParam := params.One(b, "per", false, params.ParseInt)`,
+    "var fParam controllers.Filter\n\t{\n\t\tb := b.Struct(\"f\")\n\t\tfParam.Since = params.One(b, \"since\", false, params.ParseTime)\n\t}",
+    `postParam := &models.Post{}`,
+    `postParam.Title = params.One(b, "title", true, params.ParseString)`,
+    `postParam.Tags = params.Many(b, "tags", false, params.ParseString)`,
+    "postParam.Author = &models.Author{}\n\t\t{\n\t\t\tb := b.Struct(\"by\")\n\t\t\tpostParam.Author.Name = params.One(b, \"name\", false, params.ParseString)",
+    `idsParam := params.Many(b, "ids", false, params.ParseInt64)`,
+    `draftParam := params.Ptr(b, "draft", false, params.ParseBool)`,
+    `return []reflect.Value{ reflect.ValueOf(postParam), reflect.ValueOf(idsParam), reflect.ValueOf(draftParam) }`,
+  } {
+    if !strings.Contains(string(code), want) {
+      t.Errorf("params.go doesn't contain %v:\n%s", want, code)
+    }
+  }
+  // Actions without parameters don't need binding, and ones with types eg
+  // can't bind are left to the server.
+  for _, unwanted := range []string{"PostsController.Show", "PostsController.New", "draft ="} {
+    if strings.Contains(string(code), unwanted) {
+      t.Errorf("params.go contains %v:\n%s", unwanted, code)
+    }
+  }
+}
+
+// egPackages are the packages of eg that generated code imports.
+var egPackages = []string{"params", "views"}
+
+// setupGoApp is setupApp for an app built with the go tool, against
+// testdata/ego, a stub with just the ego API the generated code may use, and
+// a module holding a copy of egPackages, so it builds whether or not this tree
+// has a go.mod of its own.
+func setupGoApp(t *testing.T, files map[string]string) *Proxy {
+  if testing.Short() {
+    t.Skip("builds an app with the go tool")
+  }
+  if _, err := exec.LookPath("go"); err != nil {
+    t.Skip("go isn't on PATH")
+  }
+  ego, _ := filepath.Abs("testdata/ego")
+  eg := t.TempDir()
+  ioutil.WriteFile(filepath.Join(eg, "go.mod"), []byte("module github.com/murz/eg\n\ngo 1.21\n"), 0666)
+  for _, pkg := range egPackages {
+    names, _ := filepath.Glob(filepath.Join("..", pkg, "*.go"))
+    os.MkdirAll(filepath.Join(eg, pkg), 0777)
+    for _, name := range names {
+      if strings.HasSuffix(name, "_test.go") {
+        continue
+      }
+      data, err := ioutil.ReadFile(name)
+      if err != nil {
+        t.Fatal(err)
+      }
+      ioutil.WriteFile(filepath.Join(eg, pkg, filepath.Base(name)), data, 0666)
+    }
+  }
+  p, _, _ := setupApp(t)
+  p.Builder = GoBuilder{}
+  t.Setenv("GOFLAGS", "-mod=mod")
+  files["go.mod"] = fmt.Sprintf("module example.com/demo\n\ngo 1.21\n\nrequire (\n\tgithub.com/murz/ego v0.0.0\n\tgithub.com/murz/eg v0.0.0\n)\n\nreplace github.com/murz/ego => %v\n\nreplace github.com/murz/eg => %v\n", ego, eg)
+  for name, content := range files {
+    os.MkdirAll(filepath.Dir(name), 0777)
+    ioutil.WriteFile(name, []byte(content), 0666)
+  }
+  return p
+}
+
+// freeAddr returns a loopback address nothing listens on.
+func freeAddr() string {
+  ln, _ := net.Listen("tcp", "127.0.0.1:0")
+  defer ln.Close()
+  return ln.Addr().String()
+}
+
+// getter returns a function that GETs paths from the app at addr, waiting for
+// it to start.
+func getter(t *testing.T, addr string) func(path string) (int, string, http.Header) {
+  return func(path string) (int, string, http.Header) {
+    for start := time.Now(); time.Since(start) < 10 * time.Second; time.Sleep(50 * time.Millisecond) {
+      resp, err := http.Get("http://" + addr + path)
+      if err != nil {
+        continue
+      }
+      body, _ := ioutil.ReadAll(resp.Body)
+      resp.Body.Close()
+      return resp.StatusCode, string(body), resp.Header
+    }
+    t.Fatalf("the app didn't answer on %v", addr)
+    return 0, "", nil
+  }
+}
+
+// goAppFiles is an app using everything eg generates.
+func goAppFiles() map[string]string {
+  return map[string]string{
+    "conf/db.go": "package conf\n\nfunc Databases() {}\n",
+    "conf/routes.go": "package conf\n\nfunc Routes() {\n\troute(\"GET\", \"/posts/:id\", \"PostsController.Show\")\n}\n\nfunc route(method, path, action string) {}\n",
+    "app/models/post.go": "package models\n\ntype Post struct {\n\tTitle string `param:\",required\"`\n}\n",
+    "app/controllers/posts_controller.go": "package controllers\n\nimport (\n\t\"example.com/demo/app/models\"\n\t\"github.com/murz/ego/http\"\n)\n\ntype PostsController struct {\n\t*http.Controller\n}\n\nfunc (c PostsController) Index() *http.Response {\n\treturn http.NotImplemented\n}\n\nfunc (c PostsController) Show(id int) *http.Response {\n\treturn http.NotImplemented\n}\n\nfunc (c PostsController) Create(post *models.Post) *http.Response {\n\treturn http.NotImplemented\n}\n",
+    "app/helpers/helpers.go": "package helpers\n\nimport \"strings\"\n\nfunc Shout(s string) string {\n\treturn strings.ToUpper(s)\n}\n",
+    "app/views/layouts/application.html": "<html><body>{{template \"content\" .}}</body></html>",
+    "app/views/posts/index.html": "<h1>{{Shout \"posts\"}}</h1>",
+    "app/views/errors/404.html": "<html><body>custom {{.Status}}</body></html>",
+    "app/views/errors/500.json": "{\"error\": {\"status\": {{.Status}}, \"message\": {{json .Message}}}}",
+  }
+}
+
+// TestGeneratedAppCompiles builds an app with everything eg generates and
+// runs it, in dev and as in production.
+func TestGeneratedAppCompiles(t *testing.T) {
+  p := setupGoApp(t, goAppFiles())
+  bin, err := p.Build()
+  if err != nil {
+    t.Fatal(err)
+  }
+  for _, f := range []string{"server.go", "handler.go", "views.go", "errors.go", "params.go"} {
+    if _, err := os.Stat(filepath.Join(".ego-genfiles", f)); err != nil {
+      t.Errorf("%v wasn't generated", f)
+    }
+  }
+  if page, err := ioutil.ReadFile("public/404.html"); err != nil || string(page) != "<html><body>custom 404</body></html>" {
+    t.Errorf("public/404.html = %q, %v", page, err)
+  }
+  if page, err := ioutil.ReadFile("public/500.json"); err != nil || string(page) != `{"error": {"status": 500, "message": "Internal Server Error"}}` {
+    t.Errorf("public/500.json = %q, %v", page, err)
+  }
+
+  addr := freeAddr()
+  cmd := exec.Command(bin, "-dev=true", "-port=" + strings.Split(addr, ":")[1])
+  cmd.Env = append(os.Environ(), "EGO_ERROR_DETAILS=true")
+  if err := cmd.Start(); err != nil {
+    t.Fatal(err)
+  }
+  defer cmd.Process.Kill()
+  get := getter(t, addr)
+  if status, body, header := get("/posts/1"); status != 200 || body != "GET /posts/1 " || header.Get(ActionHeader) != "PostsController.Show" {
+    t.Errorf("GET /posts/1 = %v %q %v, want the ego server's answer from PostsController.Show", status, body, header)
+  }
+  if status, _, _ := get("/posts/first"); status != 400 {
+    t.Errorf("GET /posts/first = %v, want the binder's 400", status)
+  }
+  if status, body, _ := get("/missing"); status != 404 || !strings.Contains(body, "custom 404") || !strings.Contains(body, "404 page not found") {
+    t.Errorf("GET /missing = %v %q, want the 404 page with details", status, body)
+  }
+  if status, body, header := get("/panic"); status != 500 || header.Get("Content-Type") != "application/json" || !strings.Contains(body, `"message": "Internal Server Error"`) || !strings.Contains(body, "panic: boom") {
+    t.Errorf("GET /panic = %v %q %v, want the JSON 500 page with details", status, body, header)
+  }
+
+  // Outside of dev the ego server answers by itself.
+  addr = freeAddr()
+  prod := exec.Command(bin, "-port=" + strings.Split(addr, ":")[1])
+  if err := prod.Start(); err != nil {
+    t.Fatal(err)
+  }
+  defer prod.Process.Kill()
+  get = getter(t, addr)
+  if status, body, header := get("/posts/first"); status != 200 || body != "GET /posts/first " || header.Get(ActionHeader) != "" {
+    t.Errorf("GET /posts/first = %v %q %v, want the ego server's own answer", status, body, header)
+  }
+}
+
+// TestControllersRenderViews has an action render a view through the views
+// package, from a test in the generated package, where the app's views are
+// registered.
+func TestControllersRenderViews(t *testing.T) {
+  files := goAppFiles()
+  files["app/controllers/pages_controller.go"] = "package controllers\n\nimport (\n\t\"github.com/murz/eg/views\"\n\t\"github.com/murz/ego/http\"\n)\n\ntype PagesController struct {\n\t*http.Controller\n}\n\nvar Rendered string\n\nfunc (c PagesController) Index() *http.Response {\n\tpage, err := views.String(\"posts/index\", nil)\n\tif err != nil {\n\t\tpanic(err)\n\t}\n\tRendered = page\n\treturn http.NotImplemented\n}\n"
+  p := setupGoApp(t, files)
+  if _, err := p.Build(); err != nil {
+    t.Fatal(err)
+  }
+  ioutil.WriteFile(".ego-genfiles/render_test.go", []byte("package main\n\nimport (\n\t\"testing\"\n\n\t\"example.com/demo/app/controllers\"\n)\n\nfunc TestRender(t *testing.T) {\n\tcontrollers.PagesController{}.Index()\n\tif controllers.Rendered != \"<html><body><h1>POSTS</h1></body></html>\" {\n\t\tt.Errorf(\"rendered %q\", controllers.Rendered)\n\t}\n}\n"), 0666)
+  cmd := exec.Command("go", "test", ".")
+  cmd.Dir = ".ego-genfiles"
+  if out, err := cmd.CombinedOutput(); err != nil {
+    t.Errorf("the action didn't render posts/index in its layout: %v\n%s", err, out)
+  }
+}
+
+func TestErrorServerBuildsAfterTheApp(t *testing.T) {
+  p := setupGoApp(t, goAppFiles())
+  l := p.Launcher.(*fakeLauncher)
+  p.start()
+  if _, err := os.Stat(".ego-genfiles/handler.go"); err != nil || len(l.args) != 1 {
+    t.Fatalf("the app wasn't built and launched: %v", err)
+  }
+
+  // The error server listens on the app's port.
+  addr := freeAddr()
+  port := config.Current().Port
+  config.Current().Port, _ = strconv.Atoi(strings.Split(addr, ":")[1])
+  defer func() {
+    config.Current().Port = port
+  }()
+  controller := "app/controllers/posts_controller.go"
+  good, _ := ioutil.ReadFile(controller)
+  ioutil.WriteFile(controller, []byte(strings.Replace(string(good), "return http.NotImplemented", "return notDeclared", 1)), 0666)
+  p.start()
+  if len(l.args) != 2 {
+    t.Fatalf("launched %v times, want the error server launched", len(l.args))
+  }
+  names, _ := filepath.Glob(".ego-genfiles/*")
+  if len(names) != 2 {
+    t.Errorf(".ego-genfiles = %v, want just the error server and its binary", names)
+  }
+
+  cmd := exec.Command(p.binPath)
+  if err := cmd.Start(); err != nil {
+    t.Fatal(err)
+  }
+  defer cmd.Process.Kill()
+  if _, body, _ := getter(t, addr)("/"); !strings.Contains(body, "undefined: notDeclared") {
+    t.Errorf("the error server answered %q, want the compile error", body)
+  }
+
+  ioutil.WriteFile(controller, good, 0666)
+  p.start()
+  if _, err := os.Stat(".ego-genfiles/handler.go"); err != nil || !p.timings.Generated || !p.timings.Compiled {
+    t.Errorf("the fixed app wasn't generated and compiled again: %v, %v", err, p.timings)
+  }
+}
+
+func TestBuildErrorStartsErrorServer(t *testing.T) {
+  p, b, l := setupApp(t)
+  b.errs = []error{errors.New("# example.com/demo/app/controllers\napp/controllers/posts_controller.go:5:32: undefined: foo")}
+
+  p.start()
+
+  if len(b.dirs) != 2 {
+    t.Fatalf("built %v times, want the app and then the error server", len(b.dirs))
+  }
+  if len(l.args) != 1 {
+    t.Fatalf("launched %v times, want just the error server", len(l.args))
+  }
+  server := readServer(t)
+  for _, want := range []string{"undefined: foo", "app/controllers/posts_controller.go", "<li class='err'>func (c PostsController) Index() {}</li>"} {
+    if !strings.Contains(server, want) {
+      t.Errorf("error server doesn't contain %v:\n%v", want, server)
+    }
+  }
+}
+
+func TestRouteErrorsStartErrorServer(t *testing.T) {
+  p, b, l := setupApp(t)
+  ioutil.WriteFile("conf/routes.go", []byte("package conf\n\nfunc Routes() {\n\troute(\"GET\", \"/posts\", \"PostsController.Index\")\n\troute(\"GET\", \"/posts/:id\", \"PostsController.Show\")\n}\n\nfunc route(method, path, action string) {}\n"), 0666)
+
+  p.start()
+
+  if len(b.dirs) != 1 || len(l.args) != 1 {
+    t.Fatalf("built %v times and launched %v, want just the error server", len(b.dirs), len(l.args))
+  }
+  server := readServer(t)
+  for _, want := range []string{"PostsController has no action Show", "conf/routes.go", "<li class='err'>\troute(&#34;GET&#34;, &#34;/posts/:id&#34;"} {
+    if !strings.Contains(server, want) {
+      t.Errorf("error server doesn't contain %v:\n%v", want, server)
+    }
+  }
+}
+
+func TestStopKillsApps(t *testing.T) {
+  p := NewProxy()
+  procs := []*fakeProcess{{}, {}}
+  for _, proc := range procs {
+    p.cmd = append(p.cmd, proc)
+  }
+
+  p.stop()
+
+  for i, proc := range procs {
+    if !proc.killed {
+      t.Errorf("process %v wasn't killed", i)
+    }
+  }
+  if len(p.cmd) != 0 {
+    t.Errorf("%v processes are still tracked", len(p.cmd))
+  }
+}
+
+func TestRequestsAreRecordedWithToolbar(t *testing.T) {
+  app := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+    body, _ := ioutil.ReadAll(r.Body)
+    w.Header().Set(ActionHeader, "PostsController.Create")
+    w.Header().Set("Content-Type", "text/html; charset=utf-8")
+    w.WriteHeader(http.StatusCreated)
+    w.Write([]byte("<html><body>" + string(body) + "</body></html>"))
+  }))
+  defer app.Close()
+  u, _ := url.Parse(app.URL)
+  p := NewProxy()
+  rp := httputil.NewSingleHostReverseProxy(u)
+  rp.ModifyResponse = p.modifyResponse
+  handler := p.record(rp)
+
+  w := httptest.NewRecorder()
+  handler.ServeHTTP(w, httptest.NewRequest("POST", "/posts?draft=1", strings.NewReader("title=hi")))
+
+  if w.Code != http.StatusCreated {
+    t.Errorf("status = %v, want 201", w.Code)
+  }
+  if w.Header().Get(ActionHeader) != "" {
+    t.Errorf("the action header wasn't stripped")
+  }
+  body := w.Body.String()
+  if !strings.Contains(body, `id="ego-toolbar"`) || !strings.HasSuffix(body, "</body></html>") {
+    t.Errorf("toolbar wasn't injected before </body>:\n%v", body)
+  }
+
+  entries := p.requests.list()
+  if len(entries) != 1 {
+    t.Fatalf("recorded %v requests, want 1", len(entries))
+  }
+  e := entries[0]
+  if e.Method != "POST" || e.URL != "/posts?draft=1" || e.Status != http.StatusCreated || e.Action != "PostsController.Create" || string(e.RequestBody) != "title=hi" || !e.Done {
+    t.Errorf("recorded %+v", e)
+  }
+
+  w = httptest.NewRecorder()
+  p.serveRequests(w, httptest.NewRequest("GET", "/_eg/requests/1", nil))
+  if !strings.Contains(w.Body.String(), "PostsController.Create") {
+    t.Errorf("inspector page doesn't show the action:\n%v", w.Body.String())
+  }
+}
+
+func TestRecordMarksTruncatedBodies(t *testing.T) {
+  big := strings.Repeat("x", bodyLimit + 10)
+  app := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+    if r.URL.Path == "/big" {
+      w.Write([]byte(big))
+      return
+    }
+    w.Write([]byte("small"))
+  }))
+  defer app.Close()
+  u, _ := url.Parse(app.URL)
+  p := NewProxy()
+  filename := filepath.Join(t.TempDir(), "session.har")
+  p.Record(filename)
+  handler := p.record(httputil.NewSingleHostReverseProxy(u))
+
+  for _, path := range []string{"/big", "/small"} {
+    w := httptest.NewRecorder()
+    handler.ServeHTTP(w, httptest.NewRequest("GET", path, nil))
+    if path == "/big" && w.Body.Len() != len(big) {
+      t.Errorf("GET /big answered %v bytes, want %v", w.Body.Len(), len(big))
+    }
+  }
+  p.recorder.Close()
+
+  f, err := har.Read(filename)
+  if err != nil {
+    t.Fatal(err)
+  }
+  if len(f.Log.Entries) != 2 {
+    t.Fatalf("recorded %v entries, want 2", len(f.Log.Entries))
+  }
+  for i, want := range []bool{true, false} {
+    if got := f.Log.Entries[i].Response.Content.Truncated; got != want {
+      t.Errorf("entry %v truncated = %v, want %v", i, got, want)
+    }
+  }
+}
+
+func TestEnvChangesRelaunchTheApp(t *testing.T) {
+  p, b, l := setupApp(t)
+  ioutil.WriteFile(".env", []byte("EG_PROXY_TEST_RELOAD=old\n"), 0666)
+  p.start()
+  first := p.cmd[0].(*fakeProcess)
+
+  ioutil.WriteFile(".env", []byte("EG_PROXY_TEST_RELOAD=new\n"), 0666)
+  p.reloadEnv()
+  if len(b.dirs) != 1 || len(l.args) != 2 {
+    t.Fatalf("built %v and launched %v times, want one build and two launches", len(b.dirs), len(l.args))
+  }
+  if !first.killed {
+    t.Errorf("the app running with the old environment wasn't stopped")
+  }
+  found := false
+  for _, kv := range l.envs[1] {
+    if kv == "EG_PROXY_TEST_RELOAD=old" {
+      t.Errorf("the relaunched app still has the old .env value")
+    }
+    found = found || kv == "EG_PROXY_TEST_RELOAD=new"
+  }
+  if !found {
+    t.Errorf("the relaunched app doesn't have the new .env value")
+  }
+}
+
+func TestRestartOnlyBuildsWhatChanged(t *testing.T) {
+  p, b, l := setupApp(t)
+  p.start()
+
+  // Nothing changed, so the running app is kept.
+  p.start()
+  if len(b.dirs) != 1 || len(l.args) != 1 {
+    t.Fatalf("built %v and launched %v times, want once each", len(b.dirs), len(l.args))
+  }
+  if p.timings.Generated || p.timings.Compiled {
+    t.Errorf("timings = %v, want generate and compile skipped", p.timings)
+  }
+
+  // A comment doesn't change the model, but the binary has to be rebuilt.
+  ioutil.WriteFile("app/controllers/posts_controller.go", []byte("package controllers\n\n// PostsController lists posts.\ntype PostsController struct{}\n\nfunc (c PostsController) Index() {}\n"), 0666)
+  p.start()
+  if !p.timings.Compiled || p.timings.Generated {
+    t.Errorf("timings = %v, want only compile", p.timings)
+  }
+  if len(l.args) != 2 {
+    t.Errorf("launched %v times, want the rebuilt app launched", len(l.args))
+  }
+
+  // A new action changes the model.
+  ioutil.WriteFile("app/controllers/posts_controller.go", []byte("package controllers\n\ntype PostsController struct{}\n\nfunc (c PostsController) Index() {}\n\nfunc (c PostsController) Show() {}\n"), 0666)
+  p.start()
+  if !p.timings.Generated || !p.timings.Compiled {
+    t.Errorf("timings = %v, want generate and compile", p.timings)
+  }
+  if !strings.Contains(readServer(t), `"PostsController.Show"`) {
+    t.Errorf("server.go wasn't regenerated with the new action")
+  }
+
+  for _, f := range []string{"app/views/posts/index.html", "public/app.css"} {
+    if needsBuild(f) {
+      t.Errorf("needsBuild(%q) = true", f)
+    }
+  }
+}
+
+func TestStaleComparesBuildHashes(t *testing.T) {
+  p, _, _ := setupApp(t)
+  if generate, compile, err := Stale(); generate || compile || err != nil {
+    t.Errorf("Stale before a build = %v, %v, %v, want nothing to do", generate, compile, err)
+  }
+  p.start()
+  if generate, compile, err := Stale(); generate || compile || err != nil {
+    t.Errorf("Stale after a build = %v, %v, %v, want nothing to do", generate, compile, err)
+  }
+
+  ioutil.WriteFile("app/controllers/posts_controller.go", []byte("package controllers\n\n// PostsController lists posts.\ntype PostsController struct{}\n\nfunc (c PostsController) Index() {}\n"), 0666)
+  if generate, compile, err := Stale(); generate || !compile || err != nil {
+    t.Errorf("Stale after a comment = %v, %v, %v, want just compile", generate, compile, err)
+  }
+  ioutil.WriteFile("app/controllers/posts_controller.go", []byte("package controllers\n\ntype PostsController struct{}\n\nfunc (c PostsController) Index() {}\n\nfunc (c PostsController) Show() {}\n"), 0666)
+  if generate, compile, err := Stale(); !generate || !compile || err != nil {
+    t.Errorf("Stale after a new action = %v, %v, %v, want generate and compile", generate, compile, err)
+  }
+}
+
+func TestServerImportsTheConfiguredDrivers(t *testing.T) {
+  p, _, _ := setupApp(t)
+  ioutil.WriteFile("go.mod", []byte("module example.com/demo\n\nrequire (\n\tgithub.com/murz/ego v0.1.0\n\tgithub.com/murz/eg v0.1.0\n\tgithub.com/lib/pq v1.10.9\n\tmodernc.org/sqlite v1.34.4\n)\n"), 0666)
+  ioutil.WriteFile("conf/app.json", []byte(`{"database": {"driver": "postgres"}, "test": {"database": {"driver": "sqlite3"}}}`), 0666)
+
+  p.start()
+
+  server := readServer(t)
+  for _, want := range []string{`_ "github.com/lib/pq"`, `_ "modernc.org/sqlite"`} {
+    if !strings.Contains(server, want) {
+      t.Errorf("server.go doesn't import %v:\n%v", want, server)
+    }
+  }
+}
+
+func TestRequireKeepsGoMod(t *testing.T) {
+  setupApp(t)
+  gomod := "module example.com/demo\n\nrequire (\n\tgithub.com/peterh/liner v1.2.2\n\tgithub.com/traefik/yaegi v0.16.1\n)\n"
+  ioutil.WriteFile("go.mod", []byte(gomod), 0666)
+  if err := Require(".", "github.com/traefik/yaegi@v0.16.1", "github.com/peterh/liner@v1.2.2"); err != nil {
+    t.Fatal(err)
+  }
+  if data, _ := ioutil.ReadFile("go.mod"); string(data) != gomod {
+    t.Errorf("go.mod changed to:\n%s", data)
+  }
+
+  os.Remove("go.mod")
+  if err := Require(".", "github.com/traefik/yaegi@v0.16.1"); err == nil {
+    t.Errorf("Require without a go.mod succeeded")
+  }
+}
+
+func TestListensOnLoopbackUnlessTold(t *testing.T) {
+  p := NewProxy()
+  if got := p.addr(5050); got != "127.0.0.1:5050" {
+    t.Errorf("addr(5050) = %q, want %q", got, "127.0.0.1:5050")
+  }
+  p.Listen("0.0.0.0")
+  if got := p.addr(5443); got != "0.0.0.0:5443" {
+    t.Errorf("addr(5443) after Listen = %q, want %q", got, "0.0.0.0:5443")
+  }
+  p.Listen("::1")
+  if got := p.addr(5050); got != "[::1]:5050" {
+    t.Errorf("addr(5050) after Listen = %q, want %q", got, "[::1]:5050")
+  }
+}
+
+func TestFaultsRejectOtherSites(t *testing.T) {
+  p := NewProxy()
+  tests := []struct {
+    path string
+    method string
+    headers map[string]string
+    want int
+  }{
+    {"/_eg/faults", "POST", nil, http.StatusSeeOther},
+    {"/_eg/faults", "POST", map[string]string{"Origin": "http://localhost:3000", "Sec-Fetch-Site": "same-origin"}, http.StatusSeeOther},
+    {"/_eg/faults", "POST", map[string]string{"Origin": "https://evil.test"}, http.StatusForbidden},
+    {"/_eg/faults", "POST", map[string]string{"Origin": "null"}, http.StatusForbidden},
+    {"/_eg/faults", "POST", map[string]string{"Sec-Fetch-Site": "cross-site"}, http.StatusForbidden},
+    {"/_eg/faults.json", "POST", nil, http.StatusOK},
+    {"/_eg/faults.json", "DELETE", map[string]string{"Origin": "https://evil.test"}, http.StatusForbidden},
+    {"/_eg/faults.json", "GET", map[string]string{"Sec-Fetch-Site": "cross-site"}, http.StatusOK},
+  }
+  for _, test := range tests {
+    r := httptest.NewRequest(test.method, "http://localhost:3000" + test.path, strings.NewReader("clear=1"))
+    r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+    for k, v := range test.headers {
+      r.Header.Set(k, v)
+    }
+    w := httptest.NewRecorder()
+    if test.path == "/_eg/faults" {
+      p.serveFaults(w, r)
+    } else {
+      p.serveFaultsJSON(w, r)
+    }
+    if w.Code != test.want {
+      t.Errorf("%v %v with %v = %v, want %v", test.method, test.path, test.headers, w.Code, test.want)
+    }
+  }
+}
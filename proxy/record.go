@@ -0,0 +1,64 @@
+package proxy
+
+import (
+  "bytes"
+  "fmt"
+  "net"
+  "time"
+  "github.com/murz/eg/config"
+  "github.com/murz/eg/har"
+)
+
+// Record saves every request that goes through the proxy to a HAR file.
+func Record(filename string) {
+  defaultProxy.Record(filename)
+}
+
+func (p *Proxy) Record(filename string) {
+  p.recorder = har.NewRecorder(filename)
+}
+
+// harEntry converts an entry for a HAR file. The toolbar is left out of the
+// body so a replay against the app itself matches, and truncated bodies are
+// marked so it doesn't compare them.
+func harEntry(e Entry) har.Entry {
+  body := e.ResponseBody
+  if e.toolbar != "" {
+    body = bytes.Replace(body, []byte(e.toolbar), nil, 1)
+  }
+  he := har.NewEntry(e.Time, e.Duration, e.Method, e.Scheme + "://" + e.Host + e.URL, e.RequestHeaders, e.RequestBody, e.Status, e.ResponseHeaders, body)
+  if he.Request.PostData != nil {
+    he.Request.PostData.Truncated = e.requestTruncated
+  }
+  he.Response.Content.Truncated = e.responseTruncated
+  return he
+}
+
+// Launch builds the app in the working directory and starts it on the
+// configured port, without the proxy or the watcher. It returns once the app
+// accepts connections.
+func Launch() (Process, error) {
+  return defaultProxy.Launch()
+}
+
+func (p *Proxy) Launch() (Process, error) {
+  if _, err := p.Build(); err != nil {
+    return nil, err
+  }
+  p.loadEnv()
+  proc, err := p.Launcher.Launch(p.binPath, p.args(), p.appEnv())
+  if err != nil {
+    return nil, err
+  }
+  addr := fmt.Sprintf("localhost:%v", config.Current().Port)
+  for i := 0; i < 100; i++ {
+    conn, err := net.Dial("tcp", addr)
+    if err == nil {
+      conn.Close()
+      return proc, nil
+    }
+    time.Sleep(100 * time.Millisecond)
+  }
+  proc.Kill()
+  return nil, fmt.Errorf("the app didn't start listening on %v", addr)
+}
@@ -0,0 +1,322 @@
+package proxy
+
+import (
+  "bytes"
+  "context"
+  "fmt"
+  "io"
+  "io/ioutil"
+  "net/http"
+  "sort"
+  "strconv"
+  "strings"
+  "sync"
+  "time"
+  "github.com/hoisie/mustache"
+  "github.com/murz/eg/templates"
+)
+
+// ActionHeader is set by the dev build's handler.go on responses to requests
+// matching a route of conf/routes.go, to the name of the route's action. The
+// proxy records and strips it.
+const ActionHeader = "X-Ego-Action"
+
+// bodyLimit is how much of each body is kept, and displayLimit how much of
+// that the inspector shows.
+const bodyLimit = 1 << 20
+const displayLimit = 4 << 10
+
+// maxEntries is how many requests the inspector remembers.
+var maxEntries = 100
+
+// Entry is a request that went through the proxy.
+type Entry struct {
+  ID int
+  Time time.Time
+  Method string
+  URL string
+  RequestHeaders http.Header
+  RequestBody []byte
+  Status int
+  ResponseHeaders http.Header
+  ResponseBody []byte
+  Duration time.Duration
+  Action string
+  // Mock describes the conf/mocks rule that answered the request, if any.
+  Mock string
+  // Fault describes the faults injected into the request, if any.
+  Fault string
+  Done bool
+  Scheme string
+  Host string
+  // toolbar is what was injected into the response body, if anything.
+  toolbar string
+  // requestTruncated and responseTruncated are set when a body was longer
+  // than bodyLimit and only its start was kept.
+  requestTruncated bool
+  responseTruncated bool
+}
+
+// requestLog keeps the most recent entries.
+type requestLog struct {
+  sync.Mutex
+  next int
+  entries []*Entry
+}
+
+func newRequestLog() *requestLog {
+  return &requestLog{
+    next: 1,
+    entries: make([]*Entry, 0),
+  }
+}
+
+func (l *requestLog) add(e *Entry) {
+  l.Lock()
+  defer l.Unlock()
+  e.ID = l.next
+  l.next++
+  l.entries = append(l.entries, e)
+  if len(l.entries) > maxEntries {
+    l.entries = l.entries[len(l.entries) - maxEntries:]
+  }
+}
+
+// update runs fn on e while holding the lock.
+func (l *requestLog) update(e *Entry, fn func(e *Entry)) {
+  l.Lock()
+  defer l.Unlock()
+  fn(e)
+}
+
+// get returns a copy of the entry with the given ID.
+func (l *requestLog) get(id int) (Entry, bool) {
+  l.Lock()
+  defer l.Unlock()
+  for _, e := range l.entries {
+    if e.ID == id {
+      return *e, true
+    }
+  }
+  return Entry{}, false
+}
+
+// list returns copies of the entries, newest first.
+func (l *requestLog) list() []Entry {
+  l.Lock()
+  defer l.Unlock()
+  entries := make([]Entry, len(l.entries))
+  for i, e := range l.entries {
+    entries[len(l.entries) - 1 - i] = *e
+  }
+  return entries
+}
+
+type entryKey struct{}
+
+// entryFor returns the entry being recorded for r, if any.
+func entryFor(r *http.Request) *Entry {
+  e, _ := r.Context().Value(entryKey{}).(*Entry)
+  return e
+}
+
+// responseRecorder keeps the status and the start of the body written through
+// it.
+type responseRecorder struct {
+  http.ResponseWriter
+  status int
+  body bytes.Buffer
+  truncated bool
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+  if r.status == 0 {
+    r.status = status
+  }
+  r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(data []byte) (int, error) {
+  if r.status == 0 {
+    r.status = http.StatusOK
+  }
+  room := bodyLimit - r.body.Len()
+  if len(data) > room {
+    r.truncated = true
+  } else {
+    room = len(data)
+  }
+  r.body.Write(data[0:room])
+  return r.ResponseWriter.Write(data)
+}
+
+func (r *responseRecorder) Flush() {
+  if f, ok := r.ResponseWriter.(http.Flusher); ok {
+    f.Flush()
+  }
+}
+
+// record wraps the handler for app requests so each one is kept in the
+// request log.
+func (p *Proxy) record(next http.Handler) http.Handler {
+  return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+    e := &Entry{
+      Time: time.Now(),
+      Method: r.Method,
+      URL: r.URL.RequestURI(),
+      Scheme: "http",
+      Host: r.Host,
+      RequestHeaders: r.Header.Clone(),
+    }
+    if r.Body != nil {
+      body, _ := ioutil.ReadAll(r.Body)
+      r.Body.Close()
+      r.Body = ioutil.NopCloser(bytes.NewReader(body))
+      e.RequestBody = truncate(body, bodyLimit)
+      e.requestTruncated = len(body) > bodyLimit
+    }
+    if r.TLS != nil {
+      e.Scheme = "https"
+    }
+    p.requests.add(e)
+
+    // The entry is finished even when the handler aborts the connection, as
+    // a dropped connection fault does. Its status is left at 0 then.
+    rec := &responseRecorder{ResponseWriter: w}
+    returned := false
+    defer func() {
+      p.requests.update(e, func(e *Entry) {
+        e.Status = rec.status
+        if e.Status == 0 && returned {
+          e.Status = http.StatusOK
+        }
+        e.ResponseHeaders = w.Header().Clone()
+        e.ResponseBody = rec.body.Bytes()
+        e.responseTruncated = rec.truncated
+        e.Duration = time.Since(e.Time)
+        e.Done = true
+      })
+      if p.recorder != nil && returned {
+        if err := p.recorder.Add(harEntry(*e)); err != nil {
+          proxyLog.Errorf("record: %v", err)
+        }
+      }
+    }()
+    next.ServeHTTP(rec, r.WithContext(context.WithValue(r.Context(), entryKey{}, e)))
+    returned = true
+  })
+}
+
+func truncate(data []byte, limit int) []byte {
+  if len(data) > limit {
+    return data[0:limit]
+  }
+  return data
+}
+
+// modifyResponse picks up the action that handled a request and injects the
+// dev toolbar into HTML pages.
+func (p *Proxy) modifyResponse(resp *http.Response) error {
+  e := entryFor(resp.Request)
+  if e == nil {
+    return nil
+  }
+  action := resp.Header.Get(ActionHeader)
+  resp.Header.Del(ActionHeader)
+  p.requests.update(e, func(e *Entry) {
+    e.Action = action
+  })
+
+  if !strings.HasPrefix(resp.Header.Get("Content-Type"), "text/html") || resp.Header.Get("Content-Encoding") != "" {
+    return nil
+  }
+  body, err := ioutil.ReadAll(resp.Body)
+  resp.Body.Close()
+  if err != nil {
+    return err
+  }
+  toolbar := mustache.Render(string(templates.Get("toolbar.html.mustache")), map[string]interface{} {
+    "ID": e.ID,
+    "Method": e.Method,
+    "URL": e.URL,
+    "Status": resp.StatusCode,
+    "Action": action,
+    "Duration": fmt.Sprintf("%.1fms", float64(time.Since(e.Time)) / float64(time.Millisecond)),
+  })
+  p.requests.update(e, func(e *Entry) {
+    e.toolbar = toolbar
+  })
+  if i := bytes.LastIndex(body, []byte("</body>")); i >= 0 {
+    body = append(body[0:i:i], append([]byte(toolbar), body[i:]...)...)
+  } else {
+    body = append(body, toolbar...)
+  }
+  resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+  resp.ContentLength = int64(len(body))
+  resp.Header.Set("Content-Length", strconv.Itoa(len(body)))
+  return nil
+}
+
+type header struct {
+  Key string
+  Value string
+}
+
+func headers(h http.Header) []header {
+  list := make([]header, 0, len(h))
+  for k, vals := range h {
+    for _, v := range vals {
+      list = append(list, header{k, v})
+    }
+  }
+  sort.Slice(list, func(i, j int) bool {
+    return list[i].Key < list[j].Key
+  })
+  return list
+}
+
+// entryView is what the request inspector templates render.
+func entryView(e Entry) map[string]interface{} {
+  view := map[string]interface{} {
+    "ID": e.ID,
+    "Time": e.Time.Format("15:04:05.000"),
+    "Method": e.Method,
+    "URL": e.URL,
+    "Status": e.Status,
+    "Error": e.Status >= 400,
+    "Action": e.Action,
+    "Mock": e.Mock,
+    "Fault": e.Fault,
+    "Done": e.Done,
+    "Duration": fmt.Sprintf("%.1fms", float64(e.Duration) / float64(time.Millisecond)),
+    "RequestHeaders": headers(e.RequestHeaders),
+    "ResponseHeaders": headers(e.ResponseHeaders),
+    "RequestBody": string(truncate(e.RequestBody, displayLimit)),
+    "ResponseBody": string(truncate(e.ResponseBody, displayLimit)),
+  }
+  return view
+}
+
+// serveRequests shows the request inspector: a list of recent requests at
+// /_eg/requests and each one at /_eg/requests/<id>.
+func (p *Proxy) serveRequests(w http.ResponseWriter, r *http.Request) {
+  w.Header().Set("Content-Type", "text/html; charset=utf-8")
+  idStr := strings.Trim(strings.TrimPrefix(r.URL.Path, "/_eg/requests"), "/")
+  if idStr == "" {
+    views := make([]map[string]interface{}, 0)
+    for _, e := range p.requests.list() {
+      views = append(views, entryView(e))
+    }
+    io.WriteString(w, mustache.Render(string(templates.Get("requests.html.mustache")), map[string]interface{} {
+      "Requests": views,
+    }))
+    return
+  }
+  id, _ := strconv.Atoi(idStr)
+  e, ok := p.requests.get(id)
+  if !ok {
+    http.Error(w, fmt.Sprintf("Request %v is no longer in the inspector.", idStr), http.StatusNotFound)
+    return
+  }
+  io.WriteString(w, mustache.Render(string(templates.Get("request.html.mustache")), entryView(e)))
+}
@@ -0,0 +1,40 @@
+// Package ego stands in for github.com/murz/ego in tests that compile and run
+// generated apps. It has only the API eg's generated code may use, which is
+// what the baseline server.go used: NewServer, Run and the -port flag.
+package ego
+
+import (
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+type Server struct {
+	name string
+}
+
+func NewServer(name string) *Server {
+	return &Server{name}
+}
+
+// Run serves on -port. /panic answers with a 500 and the panic, paths under
+// /posts with their path and body, and anything else with a 404.
+func (s *Server) Run() {
+	fs := flag.NewFlagSet(s.name, flag.ContinueOnError)
+	fs.Bool("dev", false, "")
+	port := fs.String("port", "5000", "")
+	fs.Parse(os.Args[1:])
+	http.ListenAndServe(":"+*port, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/panic":
+			http.Error(w, "panic: boom", http.StatusInternalServerError)
+		case strings.HasPrefix(r.URL.Path, "/posts"):
+			io.WriteString(w, r.Method+" "+r.URL.Path+" ")
+			io.Copy(w, r.Body)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
@@ -0,0 +1,3 @@
+module github.com/murz/ego
+
+go 1.16
@@ -0,0 +1,17 @@
+package http
+
+import (
+	"reflect"
+)
+
+type Controller struct{}
+
+type Response struct {
+	Status int
+}
+
+type Context map[string]interface{}
+
+var NotImplemented = &Response{501}
+
+func RegisterAction(name string, t reflect.Type, ctx []string, fields map[string]string) {}
@@ -0,0 +1,58 @@
+// Package seeds builds the helper binary that runs an app's db/seeds.go,
+// whose Seed(*sql.DB) error function fills the database with Go code.
+package seeds
+
+import (
+  "io/ioutil"
+  "os"
+  "path/filepath"
+  "github.com/hoisie/mustache"
+  "github.com/murz/eg/db"
+  "github.com/murz/eg/inspector"
+  "github.com/murz/eg/logger"
+  "github.com/murz/eg/proxy"
+  "github.com/murz/eg/templates"
+)
+
+const (
+  File = "db/seeds.go"
+  // Dir is the generated package of the helper, next to the server's.
+  Dir = ".ego-genfiles/seeds"
+  Bin = ".ego-genfiles/ego-seeds"
+)
+
+var buildLog = logger.New("build")
+
+// Generate writes the helper's main.go, which connects with driver to the DSN
+// in EGO_DB_DSN.
+func Generate(driver *db.Driver) error {
+  root, _ := os.Getwd()
+  module, err := inspector.ReadModule(root)
+  if err != nil {
+    return err
+  }
+  if err := os.MkdirAll(Dir, 0777); err != nil {
+    return err
+  }
+  code := mustache.Render(string(templates.Get("seeds.go.mustache")), map[string]string{
+    "Module": module,
+    "Driver": driver.Name,
+    "Import": driver.Import,
+  })
+  return ioutil.WriteFile(filepath.Join(Dir, "main.go"), []byte(code), 0666)
+}
+
+// Build generates the helper and compiles it with builder, returning the path
+// of the binary.
+func Build(builder proxy.Builder, driver *db.Driver) (string, error) {
+  root, _ := os.Getwd()
+  if err := Generate(driver); err != nil {
+    return "", err
+  }
+  if err := proxy.Require(root, driver.Module); err != nil {
+    return "", err
+  }
+  bin := filepath.Join(root, Bin)
+  buildLog.Infof("compiling %v", File)
+  return bin, builder.Build(root, filepath.Join(root, Dir), bin)
+}
@@ -0,0 +1,40 @@
+// Code generated by eg templates generate. DO NOT EDIT.
+
+package templates
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+)
+
+// Action returns the raw, uncompressed contents of action.go.mustache.
+func Action() []byte {
+	gz, err := gzip.NewReader(bytes.NewBuffer([]byte{
+0x1f,0x8b,0x08,0x00,0x00,0x00,0x00,0x00,0x02,0xff,0x3c,0x8e,
+0xc1,0xaa,0x83,0x30,0x10,0x45,0xd7,0x93,0xaf,0x18,0x5c,0x3c,
+0xf4,0x21,0xba,0x17,0xba,0x28,0xb4,0x8b,0x2e,0xaa,0x45,0xfa,
+0x03,0x41,0xa7,0x8d,0xa0,0x51,0x93,0x49,0x29,0x84,0xfc,0x7b,
+0xa9,0x91,0xee,0xce,0x1c,0xe6,0x5e,0xee,0x4b,0x1a,0xf4,0xbe,
+0x96,0x13,0x85,0x80,0x07,0x94,0x1d,0x0f,0xb3,0xb6,0x45,0x4b,
+0xcf,0xc1,0x32,0x99,0xf4,0x4f,0x31,0x2f,0xc5,0x71,0xd3,0x5e,
+0xc0,0x4d,0xb2,0xaa,0x30,0xf1,0xfe,0x0b,0x21,0x24,0xb9,0x80,
+0x2b,0xb1,0x9a,0xfb,0x4d,0x46,0x8c,0xfa,0xfc,0xa6,0xce,0x31,
+0x55,0xf8,0x70,0xba,0xc3,0xd4,0xd0,0x8a,0xff,0x5b,0x57,0x4b,
+0xab,0x23,0xcb,0x19,0xee,0x97,0x75,0x23,0xa3,0x17,0x00,0x65,
+0x89,0xf7,0xe6,0xd4,0x54,0x78,0x99,0x96,0x91,0x26,0xd2,0x8c,
+0xac,0x06,0xbb,0x6f,0x2a,0x04,0x80,0x21,0x76,0x46,0xc7,0x60,
+0x3d,0xf3,0xef,0x8f,0x7a,0x01,0x21,0x17,0x21,0xfb,0x0c,0x00,
+0x71,0xb1,0xcf,0x2d,0xce,0x00,0x00,0x00,
+	}))
+
+	if err != nil {
+		panic("Decompression failed: " + err.Error())
+	}
+
+	var b bytes.Buffer
+	io.Copy(&b, gz)
+	gz.Close()
+
+	return b.Bytes()
+}
\ No newline at end of file
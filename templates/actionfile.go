@@ -0,0 +1,32 @@
+// Code generated by eg templates generate. DO NOT EDIT.
+
+package templates
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+)
+
+// Actionfile returns the raw, uncompressed contents of actionfile.go.mustache.
+func Actionfile() []byte {
+	gz, err := gzip.NewReader(bytes.NewBuffer([]byte{
+0x1f,0x8b,0x08,0x00,0x00,0x00,0x00,0x00,0x02,0xff,0x2a,0x48,
+0x4c,0xce,0x4e,0x4c,0x4f,0x55,0x48,0x4c,0x2e,0xc9,0xcc,0xcf,
+0x2b,0xe6,0xe2,0xca,0xcc,0x2d,0xc8,0x2f,0x2a,0x51,0xd0,0xe0,
+0xe2,0x54,0x4a,0xcf,0x2c,0xc9,0x28,0x4d,0xd2,0x4b,0xce,0xcf,
+0xd5,0xcf,0x2d,0x2d,0xaa,0xd2,0x4f,0x4d,0xcf,0xd7,0x87,0xaa,
+0x53,0xc2,0x21,0x9d,0x51,0x52,0x52,0xa0,0xc4,0xa5,0xc9,0x05,
+0x18,0x00,0x0b,0xc9,0xe7,0xd0,0x57,0x00,0x00,0x00,
+	}))
+
+	if err != nil {
+		panic("Decompression failed: " + err.Error())
+	}
+
+	var b bytes.Buffer
+	io.Copy(&b, gz)
+	gz.Close()
+
+	return b.Bytes()
+}
\ No newline at end of file
@@ -0,0 +1,34 @@
+// Code generated by eg templates generate. DO NOT EDIT.
+
+package templates
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+)
+
+// AppJSON returns the raw, uncompressed contents of app.json.mustache.
+func AppJSON() []byte {
+	gz, err := gzip.NewReader(bytes.NewBuffer([]byte{
+0x1f,0x8b,0x08,0x00,0x00,0x00,0x00,0x00,0x02,0xff,0xaa,0xe6,
+0xe2,0x54,0xca,0x4b,0xcc,0x4d,0x55,0xb2,0x52,0x50,0xaa,0xae,
+0xf6,0x4b,0xcc,0x4d,0xad,0xad,0x55,0xd2,0xe1,0xe2,0x54,0x2a,
+0xc8,0x2f,0x2a,0x51,0xb2,0x52,0x30,0x35,0x30,0x30,0x00,0x73,
+0x8b,0xf2,0x2b,0x2a,0xe3,0xe1,0x82,0xa6,0x60,0xc1,0x94,0xd4,
+0xb2,0xd4,0x9c,0xfc,0x82,0xdc,0xd4,0x3c,0x90,0x68,0x35,0x17,
+0x67,0x2d,0x48,0xb4,0x24,0xb5,0x18,0x99,0x5b,0x50,0x94,0x9f,
+0x52,0x9a,0x5c,0x92,0x99,0x9f,0x07,0x15,0xe4,0xaa,0xe5,0x02,
+0x0c,0x00,0x93,0x56,0xf8,0x04,0x76,0x00,0x00,0x00,
+	}))
+
+	if err != nil {
+		panic("Decompression failed: " + err.Error())
+	}
+
+	var b bytes.Buffer
+	io.Copy(&b, gz)
+	gz.Close()
+
+	return b.Bytes()
+}
\ No newline at end of file
@@ -0,0 +1,115 @@
+// Code generated by eg templates generate. DO NOT EDIT.
+
+package templates
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+)
+
+// Console returns the raw, uncompressed contents of console.go.mustache.
+func Console() []byte {
+	gz, err := gzip.NewReader(bytes.NewBuffer([]byte{
+0x1f,0x8b,0x08,0x00,0x00,0x00,0x00,0x00,0x02,0xff,0xac,0x56,
+0x59,0x6f,0xdc,0x36,0x10,0x7e,0x16,0x7f,0xc5,0x44,0x41,0x5d,
+0x2a,0x51,0xa4,0xe6,0x75,0x83,0x2d,0x60,0x6c,0x1c,0xc4,0x40,
+0x2e,0xd4,0x39,0x1e,0x92,0xa0,0xe0,0x4a,0x23,0x2f,0x61,0x89,
+0x14,0x48,0xee,0x61,0xa8,0xfa,0xef,0xc5,0x90,0x3a,0x76,0xeb,
+0xb8,0x75,0xd1,0xbe,0x78,0xa5,0x39,0x3e,0xce,0xf7,0xcd,0x0c,
+0xe5,0x56,0x14,0x37,0xe2,0x1a,0xa1,0x11,0x52,0x31,0x26,0x9b,
+0x56,0x1b,0x07,0x9c,0x45,0x71,0xd5,0xb8,0x98,0x45,0xb1,0xb6,
+0xf4,0xd7,0x60,0x55,0x63,0xe1,0x0d,0xd6,0x19,0xa9,0xae,0x6d,
+0xcc,0x58,0x14,0x5f,0x4b,0xb7,0xd9,0xae,0xb3,0x42,0x37,0x79,
+0x8b,0x0e,0xcd,0x26,0xaf,0xa5,0x42,0x13,0x9f,0xba,0x9c,0x11,
+0x58,0xc9,0x9b,0xfc,0x56,0xe0,0xb5,0xcc,0xa5,0x72,0x68,0xda,
+0xbf,0x0d,0xb1,0xae,0xac,0xe5,0x3a,0x66,0x51,0xd7,0x3d,0x7e,
+0x2d,0xec,0x79,0xe1,0xa4,0x56,0xb6,0xef,0x59,0x14,0x77,0x1d,
+0xbc,0xd5,0xe5,0xb6,0x46,0xe8,0xfb,0x5c,0xb4,0x6d,0x5e,0x68,
+0xe5,0x8c,0xae,0x6b,0x34,0xd6,0x27,0xe4,0xa7,0x09,0x01,0xe1,
+0xad,0x2e,0xb1,0xbe,0x07,0xa0,0xf1,0xbe,0x29,0xf7,0xbe,0xd0,
+0x42,0xab,0x2a,0x66,0x09,0x63,0x79,0x0e,0xf6,0xb6,0x59,0xeb,
+0xda,0x02,0x1e,0x5a,0x6d,0xd1,0x82,0xdb,0x20,0x88,0xb6,0xfd,
+0xd9,0x42,0xa1,0x9b,0x56,0xd6,0x58,0xc2,0x20,0xab,0x05,0xa7,
+0xbd,0x3b,0xb0,0x36,0x24,0x52,0xc6,0x76,0xc2,0x4c,0x18,0xcb,
+0xc1,0x95,0x5d,0x1c,0x48,0x7a,0xdb,0xfd,0x5b,0xd6,0x27,0x0a,
+0x2c,0xa0,0x63,0x11,0x01,0xac,0x66,0x23,0x21,0x78,0x88,0x77,
+0xa2,0x21,0x80,0x78,0x01,0x43,0x3b,0xb3,0xcf,0xa2,0xde,0xe2,
+0xfb,0x8a,0xf3,0x27,0x47,0x20,0xd9,0x1c,0x9a,0x70,0x25,0xeb,
+0x24,0x49,0x3d,0x66,0xfe,0x17,0xcc,0x3e,0xfd,0x0f,0x7a,0x0f,
+0x3f,0x73,0xc1,0x73,0x0a,0xbd,0x7d,0xbc,0x6d,0xf1,0x41,0x75,
+0x07,0x98,0x7b,0x4b,0x9e,0x70,0xba,0xee,0xf1,0x67,0x61,0x1e,
+0x00,0x79,0x76,0x17,0x31,0xbb,0xa8,0xb1,0xe1,0x23,0xe4,0x08,
+0xe3,0x11,0xeb,0xed,0x43,0xca,0xbc,0x0b,0x39,0x61,0x8d,0x00,
+0x5d,0x97,0xcf,0x0a,0xcc,0xca,0x4e,0xb6,0xde,0x0f,0xde,0x5e,
+0x9b,0xd2,0x82,0x30,0x08,0xfb,0x8d,0x70,0xe0,0xc4,0xda,0x4f,
+0x5c,0x8d,0x0e,0xed,0xc2,0xcf,0x59,0x38,0x0a,0x84,0x2a,0xfd,
+0xab,0x08,0x9d,0x09,0x23,0x17,0xb2,0x97,0xf0,0xf5,0x7b,0xd8,
+0xe1,0x30,0x6a,0x5f,0xc8,0x3a,0xb6,0x8a,0x5e,0x88,0x43,0x28,
+0x60,0x74,0xf5,0x8c,0x15,0x5a,0x59,0x07,0x1b,0x69,0x9d,0x36,
+0xb7,0xaf,0x64,0x8d,0xb0,0x04,0x4a,0x78,0x7d,0x64,0xe9,0xfb,
+0x98,0xb1,0x6a,0xab,0x0a,0x7f,0x99,0xf0,0x84,0x5a,0x4b,0x6b,
+0x93,0xbd,0x14,0x4e,0xac,0x85,0x45,0xcb,0x13,0xc6,0x22,0x09,
+0x8b,0x69,0xe8,0xdf,0xe1,0x9e,0x0f,0x8f,0xef,0x5b,0x5f,0x6a,
+0xd7,0x27,0x2c,0x92,0x15,0xa0,0x31,0x3e,0x2e,0xfb,0x64,0x91,
+0x87,0x0b,0x21,0xbb,0x0a,0x3b,0x93,0xbc,0xf0,0xde,0x47,0x4b,
+0x50,0xb2,0xf6,0xf3,0x53,0x35,0x2e,0x7b,0xd5,0x1a,0xa9,0x5c,
+0xad,0xb8,0xb6,0xd9,0x95,0x2b,0xd1,0x98,0x94,0xc2,0x12,0x16,
+0x45,0xda,0x66,0x17,0x07,0xe9,0xf8,0xf3,0x84,0x45,0xfd,0x0f,
+0xd0,0xff,0x27,0xd8,0xec,0xd2,0xdf,0x9e,0x9f,0x2c,0x96,0x9e,
+0x28,0xdd,0x85,0x74,0x0a,0xfd,0x1a,0xa2,0xfa,0x86,0x1e,0x78,
+0xc2,0xa2,0x12,0x2b,0x34,0xde,0x9e,0xad,0x6a,0x6d,0x91,0x6c,
+0xfe,0xed,0x0a,0xdd,0xca,0x99,0x7a,0x75,0xbe,0xa6,0xbb,0x80,
+0x3b,0xb3,0xc5,0x63,0xd7,0xd0,0x6b,0xc3,0xc7,0xae,0x07,0xad,
+0xaa,0x74,0x24,0xa4,0x6d,0xf6,0xbe,0x45,0xc5,0x8f,0x1a,0x35,
+0xd0,0x5a,0xce,0xb4,0x3c,0xdc,0x6f,0x28,0xca,0xa1,0x79,0xbc,
+0x22,0x36,0xd5,0x5c,0x4a,0xcf,0x98,0xe7,0xfe,0x61,0xa0,0x7e,
+0x34,0xdd,0x40,0x83,0xa0,0x6b,0x4c,0x01,0x0f,0xd2,0xc1,0x5e,
+0xba,0x0d,0x50,0xc5,0xcf,0x5e,0xc6,0x09,0x8b,0x2a,0x6d,0xfc,
+0x09,0x52,0xb5,0x5b,0x37,0x15,0xe5,0xcf,0xfb,0x60,0x74,0xd3,
+0x3a,0x1e,0xff,0x0a,0x14,0x38,0xf6,0x60,0x39,0xaa,0x73,0x61,
+0x4c,0x88,0xf0,0xcc,0xb1,0xf4,0x30,0x34,0x3d,0x4e,0xaa,0x2d,
+0xb2,0x28,0xea,0xe7,0xa4,0xa3,0x0e,0x45,0x6b,0x83,0xe2,0x66,
+0x76,0x0f,0x1f,0xa7,0xec,0xa3,0x91,0xcd,0x55,0x2b,0x0a,0xe4,
+0xbe,0x94,0x84,0x0e,0x8a,0xe3,0x1f,0x82,0xfa,0xea,0xce,0xdb,
+0x16,0xd5,0xa4,0x47,0xc8,0x61,0x51,0x84,0x3b,0x51,0x73,0x99,
+0xc2,0x68,0xe8,0x4f,0x75,0x49,0xd8,0x5d,0xf9,0x57,0x06,0x85,
+0xc3,0x07,0x34,0xe0,0x8b,0x91,0x0e,0xef,0xef,0x40,0x58,0x78,
+0x2a,0x00,0xfc,0x04,0x86,0xaf,0xcc,0x8e,0x2e,0x0c,0xd0,0x15,
+0x0c,0x0a,0x6b,0xe3,0xcd,0x68,0x8c,0x36,0xf4,0xd2,0x0a,0x25,
+0x0b,0x90,0x0e,0x0a,0xb1,0xb5,0x58,0x66,0x61,0x1d,0x03,0x0d,
+0x78,0x32,0x6c,0xda,0xe5,0xfc,0x2d,0x1a,0xa8,0x0d,0xba,0xf9,
+0x8d,0x0d,0xb3,0x49,0x79,0x61,0x83,0x89,0xa1,0x27,0x67,0xb0,
+0xd0,0x3b,0x9a,0xdf,0x17,0x70,0xda,0x83,0xfb,0xd6,0x24,0xf6,
+0xd5,0x2c,0xe2,0x14,0xfc,0xba,0xd0,0xc5,0x46,0xdc,0x76,0xe9,
+0xbc,0x7e,0x17,0xbe,0xb2,0x41,0xdd,0xbb,0xed,0xfd,0x87,0x05,
+0x34,0xe8,0xb6,0x46,0x8d,0x3b,0xbd,0xcb,0x2e,0xed,0x67,0x51,
+0xcb,0x92,0x27,0x70,0x76,0x06,0xbb,0x6c,0x25,0x94,0xa7,0x5a,
+0xd1,0x1c,0x24,0x13,0xa0,0xef,0x5e,0xc5,0xe3,0x9f,0x1e,0xef,
+0xbe,0xa9,0x38,0xa5,0xbc,0x39,0xea,0x48,0xfb,0x71,0xc7,0xa6,
+0x87,0xd0,0x02,0xba,0x45,0x41,0xb8,0xa0,0xbb,0x2a,0xa9,0x19,
+0xbe,0x9f,0x41,0xea,0x31,0x96,0x93,0x6d,0x92,0x75,0xbc,0x71,
+0xa9,0x06,0xeb,0x84,0x71,0xc4,0x7e,0x9c,0xd5,0x37,0xc2,0xba,
+0x4b,0x55,0xe2,0xe1,0x5c,0xdd,0xfa,0xb4,0x14,0x62,0xf8,0xe6,
+0xd2,0x17,0x4b,0xfe,0xf5,0xe9,0xb3,0x27,0xf9,0xa3,0xb3,0x3f,
+0xe2,0x04,0x9e,0xc2,0x73,0x16,0xb5,0x06,0x2b,0x79,0x18,0x77,
+0xea,0xab,0x87,0x5a,0x7c,0xf7,0xec,0x07,0xd7,0x3c,0xe7,0x41,
+0x1c,0x92,0xd2,0x0b,0xd4,0x08,0x57,0x6c,0xd0,0x52,0x6e,0x23,
+0x6e,0x90,0x8f,0x25,0xa5,0xf0,0xcb,0xb0,0xb8,0xbf,0xa7,0xb0,
+0x27,0xb7,0x11,0xea,0x1a,0x87,0x8f,0x45,0x77,0xba,0x56,0xaf,
+0x85,0xfd,0xe0,0xcf,0xe1,0xfb,0x74,0x38,0x31,0xe8,0x3a,0xc1,
+0x2f,0xe9,0x1f,0x21,0x54,0x25,0x1f,0x0c,0x69,0xa8,0x74,0xe1,
+0x4b,0xfd,0xfe,0x74,0x3f,0x0e,0x02,0x1b,0xeb,0x1b,0xe2,0x58,
+0xcf,0xfe,0x1c,0x00,0x0d,0x83,0x51,0x78,0x8a,0x0a,0x00,0x00,
+	}))
+
+	if err != nil {
+		panic("Decompression failed: " + err.Error())
+	}
+
+	var b bytes.Buffer
+	io.Copy(&b, gz)
+	gz.Close()
+
+	return b.Bytes()
+}
\ No newline at end of file
@@ -1,3 +1,5 @@
+// Code generated by eg templates generate. DO NOT EDIT.
+
 package templates
 
 import (
@@ -6,10 +8,10 @@ import (
 	"io"
 )
 
-// Controller returns the raw, uncompressed file data data.
+// Controller returns the raw, uncompressed contents of controller.go.mustache.
 func Controller() []byte {
 	gz, err := gzip.NewReader(bytes.NewBuffer([]byte{
-0x1f,0x8b,0x08,0x00,0x00,0x09,0x6e,0x88,0x00,0xff,0x3c,0xcc,
+0x1f,0x8b,0x08,0x00,0x00,0x00,0x00,0x00,0x02,0xff,0x3c,0xcc,
 0xb1,0x6e,0x83,0x30,0x10,0x80,0xe1,0x99,0x7b,0x8a,0x13,0x13,
 0x74,0xc0,0x7b,0xbb,0xb6,0x03,0x0b,0x48,0x55,0x5f,0xc0,0x98,
 0x2b,0x58,0xc1,0x3e,0xcb,0x3e,0x4b,0x49,0x2c,0xbf,0x7b,0x44,
@@ -23,8 +25,7 @@ func Controller() []byte {
 0x7d,0xa2,0x13,0x2a,0x85,0x7f,0xf3,0xf7,0xfc,0x89,0xa3,0x0b,
 0x07,0x39,0xf2,0x82,0xb2,0xdb,0x84,0xda,0x88,0x65,0x3f,0x40,
 0x13,0x49,0x72,0xf4,0xf8,0x86,0x13,0xcb,0x95,0xd1,0xfa,0x05,
-0xf5,0x15,0x00,0x00,0xff,0xff,0x1d,0xe1,0xa5,0xce,0xcc,0x00,
-0x00,0x00,
+0xf5,0x35,0x00,0x1d,0xe1,0xa5,0xce,0xcc,0x00,0x00,0x00,
 	}))
 
 	if err != nil {
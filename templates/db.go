@@ -1,3 +1,5 @@
+// Code generated by eg templates generate. DO NOT EDIT.
+
 package templates
 
 import (
@@ -6,19 +8,34 @@ import (
 	"io"
 )
 
-// Databases returns raw, uncompressed file data.
-func Databases() []byte {
+// DB returns the raw, uncompressed contents of db.go.mustache.
+func DB() []byte {
 	gz, err := gzip.NewReader(bytes.NewBuffer([]byte{
-0x1f,0x8b,0x08,0x00,0x00,0x09,0x6e,0x88,0x00,0xff,0x34,0xcb,
-0x31,0x0e,0xc2,0x30,0x0c,0x05,0xd0,0xfd,0x9f,0xe2,0xab,0x13,
-0x2c,0xf5,0x19,0x90,0xb8,0x88,0x93,0xb8,0x69,0x85,0x52,0x57,
-0x69,0x3c,0x00,0xe2,0xee,0xb0,0x30,0x3f,0xbd,0x43,0xf3,0x43,
-0xab,0x31,0xfb,0xbe,0x00,0x22,0xdc,0xda,0xe1,0x7d,0x70,0xaa,
-0xdb,0x58,0x23,0xcd,0xd9,0x9b,0xb4,0xe8,0x2f,0xb1,0xea,0x52,
-0xd2,0x04,0x2c,0xb1,0x67,0xde,0x75,0x68,0xd2,0xd3,0xce,0xcb,
-0x95,0x6f,0x90,0xbf,0x78,0x2b,0x85,0x4f,0x8f,0xce,0xf2,0x37,
-0xae,0xd6,0x6d,0xc6,0x07,0xf8,0x06,0x00,0x00,0xff,0xff,0x5c,
-0x9e,0x7f,0x51,0x66,0x00,0x00,0x00,
+0x1f,0x8b,0x08,0x00,0x00,0x00,0x00,0x00,0x02,0xff,0x4c,0x90,
+0x41,0x6b,0xe3,0x30,0x10,0x85,0xcf,0xd6,0xaf,0x98,0xf5,0x49,
+0x82,0x60,0xdf,0xb3,0xe4,0xb0,0xec,0xb2,0xb7,0x42,0x29,0xf4,
+0x07,0x8c,0xa5,0xb1,0xad,0x56,0xd2,0x88,0x91,0x42,0x68,0x4b,
+0xfe,0x7b,0x91,0x02,0x69,0x8e,0x7a,0x7a,0xdf,0x7b,0x33,0x93,
+0xd1,0xbe,0xe3,0x46,0x60,0x39,0xad,0x4a,0xf9,0x98,0x59,0x2a,
+0x68,0x35,0x8c,0x81,0xb7,0x51,0xa9,0x61,0xdc,0x7c,0xdd,0xcf,
+0xcb,0x64,0x39,0xce,0xf1,0x2c,0x9f,0x33,0x6d,0xb3,0x5b,0x46,
+0x65,0x94,0x9a,0x67,0xf8,0x87,0x15,0x17,0x2c,0x54,0x1a,0x9f,
+0xc8,0xd6,0x02,0x95,0xa1,0xee,0x04,0xee,0xfe,0xe3,0x53,0x0f,
+0x9f,0x31,0xe7,0xe9,0xad,0x70,0x82,0xcb,0x4e,0xa9,0x7b,0x30,
+0x67,0x28,0x15,0xa5,0x96,0x63,0x0b,0x6b,0x52,0x16,0x1f,0x51,
+0x3e,0x80,0x13,0x35,0xf0,0x31,0x09,0x0a,0xd9,0xea,0x39,0x01,
+0x26,0xd7,0x3f,0x12,0x46,0x72,0xcd,0xd9,0x3b,0xee,0x85,0x53,
+0xcb,0x7a,0x62,0x47,0xa1,0x80,0x10,0xda,0xbd,0x99,0x23,0x5c,
+0x7c,0xdd,0xc1,0x2d,0xd3,0x6b,0x21,0x3d,0x36,0x74,0x34,0x07,
+0x60,0x69,0xd2,0x7f,0x16,0x1d,0x1b,0x60,0x60,0x65,0x01,0x84,
+0xfe,0xb8,0x11,0xf8,0xb8,0x26,0x44,0xaa,0x3b,0xbb,0x43,0x1f,
+0xa1,0x50,0x72,0xad,0xc0,0xdd,0x77,0x16,0xca,0xc1,0x5b,0x2c,
+0x37,0xf2,0x85,0xd0,0x91,0x68,0x33,0xa9,0xf5,0x9c,0xec,0xcf,
+0xa9,0xb4,0x81,0x2f,0x35,0xf8,0x15,0x48,0x04,0x8e,0xa7,0x36,
+0xc0,0xdf,0xdb,0xf1,0xfe,0x84,0xa0,0xcd,0xef,0xae,0xff,0x3a,
+0x41,0xf2,0xa1,0x19,0x87,0xc0,0xdb,0xf4,0x2c,0x3e,0x55,0x4d,
+0x22,0x46,0x0d,0x57,0x75,0x55,0xdf,0x03,0x00,0x61,0xdd,0x2e,
+0xc9,0xb6,0x01,0x00,0x00,
 	}))
 
 	if err != nil {
@@ -0,0 +1,44 @@
+// Code generated by eg templates generate. DO NOT EDIT.
+
+package templates
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+)
+
+// DBJSON returns the raw, uncompressed contents of db.json.mustache.
+func DBJSON() []byte {
+	gz, err := gzip.NewReader(bytes.NewBuffer([]byte{
+0x1f,0x8b,0x08,0x00,0x00,0x00,0x00,0x00,0x02,0xff,0x9c,0x90,
+0xcd,0x6a,0xc3,0x30,0x0c,0x80,0xcf,0xd2,0x53,0x04,0x9d,0xcb,
+0x5a,0x36,0x7a,0x31,0x8c,0xed,0x09,0xf6,0x02,0x63,0x04,0x35,
+0xd6,0x36,0x83,0x6c,0x07,0xcb,0xec,0x87,0xe0,0x77,0x1f,0x49,
+0xd7,0xd0,0x43,0xbb,0xc3,0x8e,0xfe,0x24,0xe4,0x8f,0x6f,0x42,
+0x20,0xcf,0x95,0x0f,0x6c,0x42,0xae,0x9b,0x10,0x80,0x7c,0x09,
+0x1f,0x52,0xc8,0x75,0x34,0x66,0xab,0x6f,0x45,0x8c,0x36,0x0b,
+0xb7,0x74,0x0e,0xdd,0x76,0x3b,0x4d,0x4f,0x1c,0xa5,0x35,0x37,
+0xb2,0xd9,0x67,0x2e,0xfe,0x51,0xf3,0xc0,0xfa,0x9e,0xad,0xae,
+0xb3,0x07,0x33,0x8d,0xd9,0xcb,0xbd,0x0f,0xc6,0x07,0x95,0xe3,
+0xad,0xc8,0x5f,0x7d,0x1e,0x25,0xf5,0x43,0x4e,0xc9,0xc8,0x75,
+0xb7,0xbb,0x95,0x07,0xaf,0xb2,0xf2,0xfd,0x82,0xe7,0x57,0x3f,
+0xcf,0x34,0xbc,0x4a,0x0d,0x71,0x76,0xa5,0xbb,0x5d,0x3c,0x1e,
+0x2b,0x32,0x6a,0x18,0x78,0x5e,0x7f,0x46,0x00,0xf8,0x5b,0xf1,
+0x77,0xfb,0x66,0x51,0xbd,0xae,0x89,0x00,0x2f,0x08,0x6d,0x73,
+0x16,0xc8,0x4e,0x85,0x38,0xb1,0x7e,0xd7,0x30,0x9c,0xc0,0xd5,
+0x66,0xff,0x8d,0xd6,0xaf,0x3f,0x5c,0xcc,0x77,0xa1,0xdf,0x1e,
+0x01,0x1a,0x42,0xc3,0x86,0x3f,0x03,0x00,0x2b,0x88,0xee,0x92,
+0xd4,0x01,0x00,0x00,
+	}))
+
+	if err != nil {
+		panic("Decompression failed: " + err.Error())
+	}
+
+	var b bytes.Buffer
+	io.Copy(&b, gz)
+	gz.Close()
+
+	return b.Bytes()
+}
\ No newline at end of file
@@ -0,0 +1,35 @@
+// Code generated by eg templates generate. DO NOT EDIT.
+
+package templates
+
+// defaults maps template names (the file names in templates/mustache) to the
+// embedded data that is used when no override exists.
+var defaults = map[string]func() []byte{
+	"action.go.mustache":     Action,
+	"actionfile.go.mustache": Actionfile,
+	"app.json.mustache":      AppJSON,
+	"console.go.mustache":    Console,
+	"controller.go.mustache": Controller,
+	"db.go.mustache":         DB,
+	"db.json.mustache":       DBJSON,
+	"error.html.mustache":    ErrorHTML,
+	"error.json.mustache":    ErrorJSON,
+	"errors.go.mustache":     Errors,
+	"errserver.go.mustache":  Errserver,
+	"faults.html.mustache":   FaultsHTML,
+	"go.mod.mustache":        GoMod,
+	"handler.go.mustache":    Handler,
+	"helper.go.mustache":     Helper,
+	"layout.html.mustache":   LayoutHTML,
+	"model.go.mustache":      Model,
+	"params.go.mustache":     Params,
+	"request.html.mustache":  RequestHTML,
+	"requests.html.mustache": RequestsHTML,
+	"routes.go.mustache":     Routes,
+	"seeds.go.mustache":      Seeds,
+	"server.go.mustache":     Server,
+	"tests.html.mustache":    TestsHTML,
+	"toolbar.html.mustache":  ToolbarHTML,
+	"view.html.mustache":     ViewHTML,
+	"views.go.mustache":      Views,
+}
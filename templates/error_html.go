@@ -0,0 +1,50 @@
+// Code generated by eg templates generate. DO NOT EDIT.
+
+package templates
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+)
+
+// ErrorHTML returns the raw, uncompressed contents of error.html.mustache.
+func ErrorHTML() []byte {
+	gz, err := gzip.NewReader(bytes.NewBuffer([]byte{
+0x1f,0x8b,0x08,0x00,0x00,0x00,0x00,0x00,0x02,0xff,0x54,0x51,
+0xc1,0x6e,0xab,0x30,0x10,0x3c,0x93,0xaf,0xd8,0x47,0x2e,0xaf,
+0x12,0x04,0x10,0xa5,0x07,0xe2,0x46,0xea,0xad,0x97,0xf6,0x1f,
+0x36,0xf6,0x06,0xac,0x1a,0x1b,0xd9,0x4e,0x0b,0x8d,0xf2,0xef,
+0x95,0x4d,0xda,0x24,0x42,0xc2,0xda,0xd9,0xdd,0xd1,0xcc,0x2c,
+0xfb,0x27,0x0c,0xf7,0xf3,0x48,0xd0,0xfb,0x41,0xed,0x56,0x6c,
+0x79,0x12,0xd6,0x13,0x8a,0xdd,0x2a,0x49,0x98,0x97,0x5e,0xd1,
+0xee,0x74,0x7a,0x23,0xe7,0xb0,0xa3,0xf3,0x99,0x15,0x0b,0x14,
+0x9a,0xce,0xcf,0x8a,0x20,0xec,0x3f,0xa7,0x9e,0x26,0x5f,0x70,
+0xe7,0xd2,0xd0,0x49,0x02,0x4f,0x06,0x7b,0x23,0x66,0x38,0x85,
+0x3a,0xd9,0x23,0xff,0xe8,0xac,0x39,0x6a,0xd1,0xc2,0x9a,0x88,
+0xb6,0x11,0x1d,0x51,0x08,0xa9,0xbb,0x16,0xca,0xa5,0x1e,0xd0,
+0x76,0x52,0xff,0x96,0xe7,0xf0,0xbb,0xe1,0x38,0x18,0xed,0xf3,
+0x03,0x0e,0x52,0xcd,0x2d,0xa4,0xaf,0xa4,0x3e,0xc9,0x4b,0x8e,
+0xf0,0x4e,0x47,0x4a,0xb3,0x17,0x2b,0x51,0x65,0x0e,0xb5,0xcb,
+0x1d,0x59,0x79,0x58,0x18,0xb9,0x51,0xc6,0xb6,0xb0,0xae,0xf1,
+0xb1,0x6e,0xea,0x7b,0xac,0x69,0x9a,0x05,0x08,0xda,0x73,0xd7,
+0xa3,0x30,0x5f,0x6d,0x09,0xf5,0x38,0x41,0x55,0x8e,0x13,0xd8,
+0x6e,0x8f,0xff,0xcb,0x2c,0x7e,0x9b,0xfa,0xe1,0x66,0x16,0x95,
+0xec,0x74,0x0b,0x9c,0xb4,0x27,0x7b,0xd5,0xda,0x57,0x17,0xa5,
+0x8a,0xbc,0x27,0x9b,0xbb,0x11,0x79,0xb4,0x97,0x97,0x9b,0x8a,
+0x86,0xed,0xd5,0x85,0x93,0xdf,0xd4,0x36,0x4f,0xe3,0xf4,0xb7,
+0xcc,0x8a,0x98,0x66,0x08,0xbf,0xb8,0xa4,0xcf,0x82,0xf7,0x18,
+0x74,0x5f,0xdd,0x9f,0xa0,0xaf,0xe2,0xdc,0xd2,0x67,0x45,0xbc,
+0xda,0xcf,0x00,0x61,0xd4,0x4f,0xc1,0xcc,0x01,0x00,0x00,
+	}))
+
+	if err != nil {
+		panic("Decompression failed: " + err.Error())
+	}
+
+	var b bytes.Buffer
+	io.Copy(&b, gz)
+	gz.Close()
+
+	return b.Bytes()
+}
\ No newline at end of file
@@ -0,0 +1,33 @@
+// Code generated by eg templates generate. DO NOT EDIT.
+
+package templates
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+)
+
+// ErrorJSON returns the raw, uncompressed contents of error.json.mustache.
+func ErrorJSON() []byte {
+	gz, err := gzip.NewReader(bytes.NewBuffer([]byte{
+0x1f,0x8b,0x08,0x00,0x00,0x00,0x00,0x00,0x02,0xff,0xaa,0xae,
+0xb6,0xb5,0x51,0x55,0x50,0xb5,0xb3,0xad,0xad,0xe5,0xaa,0xe6,
+0xe2,0x54,0x4a,0x2d,0x2a,0xca,0x2f,0x52,0xb2,0x52,0xa8,0xe6,
+0xe2,0xe4,0x54,0x2a,0x2e,0x49,0x2c,0x29,0x2d,0x06,0xf1,0xaa,
+0xf5,0x82,0xc1,0xec,0xda,0x5a,0x1d,0x90,0x44,0x6e,0x6a,0x71,
+0x71,0x62,0x7a,0x2a,0x58,0x26,0xab,0x38,0x3f,0x4f,0x41,0xcf,
+0x17,0x22,0x52,0x5b,0xcb,0xc5,0x59,0xcb,0x55,0x0b,0x18,0x00,
+0xa9,0xd1,0x89,0xfc,0x56,0x00,0x00,0x00,
+	}))
+
+	if err != nil {
+		panic("Decompression failed: " + err.Error())
+	}
+
+	var b bytes.Buffer
+	io.Copy(&b, gz)
+	gz.Close()
+
+	return b.Bytes()
+}
\ No newline at end of file
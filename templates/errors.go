@@ -0,0 +1,224 @@
+// Code generated by eg templates generate. DO NOT EDIT.
+
+package templates
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+)
+
+// Errors returns the raw, uncompressed contents of errors.go.mustache.
+func Errors() []byte {
+	gz, err := gzip.NewReader(bytes.NewBuffer([]byte{
+0x1f,0x8b,0x08,0x00,0x00,0x00,0x00,0x00,0x02,0xff,0xac,0x58,
+0x6f,0x73,0xdb,0x36,0xd2,0x7f,0x2d,0x7e,0x8a,0x0d,0x5b,0xa7,
+0xe4,0x13,0x9a,0x8a,0x9f,0xcc,0xb4,0x37,0xb2,0xa4,0x99,0xf4,
+0xe2,0xb6,0xe9,0xd8,0x4d,0xc7,0x4e,0xae,0x2f,0x7c,0x9e,0x14,
+0x22,0x41,0x09,0x35,0x05,0xf0,0x00,0x48,0xb6,0x8f,0xe5,0x77,
+0xbf,0xd9,0x05,0x48,0x51,0x7f,0x9c,0x38,0x33,0x99,0xc9,0x4c,
+0x2c,0x60,0x77,0xb1,0xfb,0xdb,0xc5,0xee,0x0f,0xac,0x58,0x76,
+0xcb,0xe6,0x1c,0x96,0x4c,0xc8,0x20,0x10,0xcb,0x4a,0x69,0x0b,
+0x51,0x30,0x08,0x67,0x0f,0x96,0x9b,0x30,0x18,0x84,0x5c,0x66,
+0x2a,0x17,0x72,0x3e,0xfc,0xcb,0x28,0x89,0x0b,0xc5,0xd2,0xe2,
+0x7f,0x0b,0xbb,0x2c,0x87,0x96,0x2f,0xab,0x92,0x59,0x8e,0x0b,
+0x42,0x0d,0x85,0x5a,0x59,0x51,0x86,0xc1,0x40,0x72,0xbb,0xb0,
+0xb6,0x82,0x50,0x72,0x3b,0xc4,0xbf,0x50,0x40,0x91,0xbd,0x8a,
+0xd9,0xc5,0xb0,0x10,0x25,0xc7,0x3f,0x70,0xc1,0x58,0x2d,0xe4,
+0x1c,0xf7,0x2c,0xbf,0xb7,0xad,0x45,0x08,0xf1,0x57,0xef,0x80,
+0x60,0x10,0xce,0x85,0x5d,0xac,0x66,0x69,0xa6,0x96,0xc3,0xe5,
+0x4a,0xff,0x77,0xc8,0xe7,0xc3,0xb5,0xe0,0x77,0x26,0x0c,0xe2,
+0x20,0x18,0x0e,0x81,0x6b,0xad,0xf4,0xef,0x6c,0xce,0x0d,0x30,
+0xcd,0xc1,0x2e,0x38,0xa0,0x97,0x40,0x42,0x20,0x24,0xb0,0xaa,
+0x72,0x1a,0x43,0x12,0x35,0x09,0xcc,0x1e,0x48,0xcc,0x58,0x66,
+0x57,0x06,0xff,0x7c,0xf8,0x4e,0x73,0x28,0x94,0x4e,0x83,0x35,
+0xd3,0x7d,0x8b,0x13,0x58,0xb2,0xea,0x5a,0x48,0x7b,0xe3,0x1c,
+0xae,0x83,0x41,0x5d,0x7f,0xf3,0xcb,0xfb,0x8b,0xf3,0xb3,0x4e,
+0xa8,0x69,0x70,0x11,0xae,0x9c,0xb5,0xa6,0x19,0x41,0x58,0xd7,
+0xf0,0x2f,0xc1,0xef,0xa0,0x69,0xc2,0x04,0x37,0x87,0x7b,0x1a,
+0x0d,0xf9,0x8e,0xe8,0x9e,0xed,0xfb,0xff,0xeb,0xd5,0xbb,0xdf,
+0xa0,0xa2,0xa5,0xa7,0xfb,0x8f,0xf6,0x30,0x04,0x78,0xef,0x7e,
+0xc3,0x16,0x94,0x06,0xee,0x84,0x5d,0x00,0xa3,0x23,0xa1,0x58,
+0xc9,0xcc,0x0a,0x25,0x13,0xb8,0x5b,0x88,0x6c,0x01,0x94,0x6e,
+0x74,0x00,0xd6,0xac,0x5c,0xf1,0x14,0x6d,0xbd,0x95,0x90,0xf3,
+0x75,0x87,0x8e,0xe6,0x2c,0x87,0x42,0xab,0x25,0xe4,0xc2,0xdc,
+0x26,0x50,0x8a,0x5b,0xe7,0x2c,0xb9,0xe6,0x80,0xdb,0x09,0xe7,
+0x30,0x78,0x18,0xdc,0xa7,0xc0,0xab,0xeb,0x1a,0xce,0x85,0xe5,
+0x9a,0x95,0xd0,0x34,0x8d,0xc3,0x6f,0x4f,0xa9,0xd9,0xe4,0xfe,
+0x0d,0xb3,0x0c,0x84,0x81,0xbb,0x05,0xb3,0xc0,0xa4,0x5b,0x24,
+0xf8,0x70,0x55,0x73,0x99,0x73,0xcd,0x73,0x8a,0x3f,0x0d,0xec,
+0x43,0xc5,0x7b,0x6a,0xc6,0xea,0x55,0x66,0xa1,0x0e,0x06,0xde,
+0x03,0x21,0x6d,0x30,0xb8,0xe0,0xc6,0xa0,0xba,0x73,0x1b,0xcf,
+0x42,0xc0,0x40,0x48,0x61,0xa3,0x18,0x85,0x0b,0xa5,0x41,0x24,
+0xc0,0xf4,0x1c,0x46,0x13,0xd0,0x4c,0xce,0x39,0x28,0x93,0xbe,
+0xd6,0x73,0x83,0xdb,0x03,0x51,0xd0,0xde,0x64,0x02,0xe1,0x71,
+0xa5,0x79,0xa6,0x96,0x95,0x28,0xf9,0xb1,0xcb,0x5f,0x08,0xcf,
+0x9f,0x83,0x78,0x71,0x02,0x63,0x28,0xb9,0x8c,0xbc,0x1e,0xd9,
+0x25,0x4d,0xae,0x35,0x5a,0xdd,0xe8,0x51,0xe0,0xa6,0x15,0xbc,
+0x16,0x2f,0x4e,0x6e,0xe2,0x53,0x12,0x7b,0x36,0x01,0x29,0x4a,
+0xa7,0x39,0x28,0x96,0x36,0xfd,0xa9,0xd2,0x42,0xda,0x92,0xac,
+0x5e,0xd9,0x9c,0x6b,0x9d,0xa0,0x60,0x4c,0x02,0xca,0xa4,0x67,
+0xf7,0xc2,0x46,0x27,0xf4,0xb3,0x09,0x7a,0x4b,0x2f,0x71,0xa9,
+0x09,0xf0,0x5f,0x57,0xfd,0x30,0xf1,0xe0,0x75,0xc0,0xa3,0xd5,
+0x9f,0xb9,0xe5,0x72,0x1d,0x85,0x67,0x3f,0xbf,0xfb,0x78,0x76,
+0x79,0xf9,0xee,0xf2,0xe3,0x9b,0xb3,0xf7,0xaf,0xdf,0x9e,0x5f,
+0x85,0x31,0xc5,0x6b,0xf5,0x8a,0x87,0xb1,0xcf,0xcf,0x6e,0x0c,
+0xde,0xa0,0x01,0xce,0xb0,0xea,0x36,0x89,0xa2,0xc2,0xc2,0x6a,
+0xe2,0xcb,0x19,0xcf,0x73,0x9e,0xfb,0xeb,0x6b,0x15,0x9a,0xc9,
+0x85,0x1e,0x8e,0x5d,0xb5,0x4f,0x53,0xba,0xdc,0x4a,0x6f,0x2f,
+0x62,0xe5,0x25,0xdd,0xa5,0x10,0x99,0xbf,0x3d,0x86,0xeb,0x35,
+0xcf,0x41,0xad,0xac,0x11,0x39,0x07,0x55,0x90,0x35,0xbe,0x86,
+0xa5,0xca,0x79,0xea,0xb2,0xba,0x87,0x73,0x2e,0xb4,0xcf,0x7c,
+0xec,0x5d,0xac,0x83,0x5e,0x5e,0x94,0x49,0x2f,0x6e,0x73,0xa1,
+0x5f,0x97,0x25,0x8a,0x26,0xf0,0xf2,0x87,0x1f,0x7e,0xd8,0xcf,
+0x87,0xe6,0x76,0xa5,0xa9,0x18,0x09,0x55,0xac,0x18,0xe7,0x6c,
+0x42,0xa1,0x6d,0xea,0xa6,0xd7,0x6d,0x50,0x0f,0xef,0xd1,0x6c,
+0x55,0x00,0xb5,0xe2,0xf4,0xc7,0x55,0x51,0x70,0x1d,0xf4,0xeb,
+0xc2,0xdd,0xb7,0x4b,0x02,0x32,0x7a,0x3e,0x5b,0x15,0xce,0x60,
+0xb2,0xa9,0xea,0xba,0x3d,0xc8,0x37,0xe4,0xd4,0xd5,0xf6,0x7b,
+0x7e,0x6f,0x23,0xb7,0x15,0x37,0x07,0x0a,0xc8,0x7b,0x8c,0x65,
+0x44,0x48,0x14,0x51,0x78,0xb4,0x1e,0xc1,0xd1,0x3a,0xec,0x9d,
+0xe0,0xeb,0xa4,0xe7,0x8f,0x6b,0xff,0xe9,0x1f,0x5a,0x58,0xfe,
+0x93,0x28,0x79,0xd4,0xb6,0xf9,0xf4,0x57,0x25,0xa4,0x83,0x08,
+0x6d,0x5e,0x51,0x69,0x92,0x51,0xca,0x61,0x98,0x78,0x40,0xe2,
+0x38,0xc1,0x88,0xd3,0x1f,0x31,0xe2,0x28,0x4e,0xe0,0xe5,0xf7,
+0xdf,0x7f,0xff,0xb8,0x7f,0x84,0xe8,0xa0,0xd9,0x41,0x75,0x83,
+0xe7,0x4e,0x13,0x42,0x55,0x2c,0x86,0xa4,0xf5,0xd7,0x95,0x20,
+0x36,0x14,0x2a,0xe9,0x16,0xab,0x82,0x95,0x86,0xc7,0x9b,0xc8,
+0x3e,0x83,0x8c,0xbb,0xca,0xc3,0xa3,0x35,0x95,0x9e,0x47,0xa9,
+0xb5,0xf5,0xd5,0x70,0xa2,0xe9,0xdb,0xc7,0xc9,0x45,0xf2,0x74,
+0x80,0xfc,0x92,0x14,0xa5,0xbf,0x91,0xdb,0xd1,0x77,0xf7,0x71,
+0x6b,0xea,0xc0,0x06,0x56,0x7f,0x47,0x0e,0x62,0x86,0xbd,0x32,
+0xa1,0xdb,0x34,0x53,0xaa,0x8c,0x21,0xba,0xbe,0xc1,0xa2,0xf5,
+0x85,0x48,0xfd,0x0c,0x47,0x10,0xc6,0xbe,0x9d,0x93,0x6b,0xa7,
+0x7f,0x43,0xb7,0x0a,0xf5,0xd1,0xfb,0x9c,0x59,0x96,0xec,0x60,
+0x75,0xc9,0x59,0x7e,0x00,0xaa,0x70,0x77,0x22,0x86,0x9f,0x45,
+0xee,0xd3,0x89,0x95,0xa2,0x4c,0x36,0xb0,0x39,0xaf,0x27,0xbe,
+0x07,0x44,0xe8,0x58,0x4c,0x60,0xda,0xce,0xbf,0x3e,0x67,0x49,
+0x7f,0xe3,0x77,0x6d,0x3d,0x84,0x71,0xfa,0xd3,0x4a,0x66,0x26,
+0xda,0x12,0xc0,0xa5,0x0b,0x56,0xe1,0x89,0x21,0xf9,0x35,0xa2,
+0x01,0x1c,0xad,0x11,0x41,0xae,0x0b,0x96,0xf1,0xba,0x89,0x21,
+0x72,0x07,0xf6,0xf1,0xdb,0x81,0x05,0x95,0xd3,0x0b,0xa6,0xcd,
+0x82,0x95,0xd1,0x3a,0xee,0x45,0xd0,0xf7,0xb5,0x0b,0x25,0x09,
+0x06,0x4d,0x9c,0xfe,0xce,0xb4,0xe1,0xe4,0x50,0x1c,0x1c,0xc0,
+0x60,0x0f,0x82,0x26,0x78,0xa4,0x07,0xa1,0xe2,0x04,0x6c,0x7a,
+0x76,0xcf,0xb3,0x95,0xe5,0xbe,0xf7,0x7c,0x59,0xd7,0xe9,0x2a,
+0x72,0xeb,0xc2,0xe3,0xb9,0xae,0x3c,0xef,0x98,0xb4,0x86,0x0a,
+0x51,0x73,0x64,0xa5,0x38,0xd5,0xb9,0x5d,0x70,0x0d,0x1a,0x98,
+0xb9,0x35,0x54,0x9a,0x6e,0x9f,0xd1,0xb2,0x5d,0x30,0x49,0x7c,
+0xcf,0x57,0x6a,0x67,0x20,0xd2,0xf0,0x7f,0xad,0x27,0x97,0xfc,
+0x3f,0x2b,0x6e,0x6c,0x4c,0x85,0x8a,0x51,0xb3,0x2c,0xe3,0x15,
+0x55,0xa6,0x4e,0x7f,0xe1,0x2c,0xe7,0x1a,0xe7,0x5a,0x14,0xbe,
+0xa6,0xf5,0x30,0x0e,0xb6,0x71,0x35,0xe9,0x3f,0x95,0xb4,0x4c,
+0x48,0x13,0x39,0xcd,0x04,0x5c,0x22,0x63,0x1c,0xe5,0xcf,0x1e,
+0x17,0xa2,0x3e,0x17,0x6f,0x5d,0xbd,0xee,0x1a,0x80,0x3b,0xc2,
+0xdd,0xbd,0xcd,0xd0,0x55,0x05,0x2d,0xb0,0xaa,0x4a,0xe1,0x75,
+0xef,0x4e,0x0a,0x03,0x95,0xc8,0x6e,0x79,0x8e,0x10,0xa0,0xb9,
+0xac,0x14,0x5c,0x5a,0x83,0xb0,0x08,0x39,0xef,0x80,0x49,0x40,
+0x69,0x10,0x64,0x44,0xf3,0xef,0x0c,0x48,0x45,0xf0,0x6c,0x2e,
+0xf6,0x86,0x39,0xa6,0xf0,0x87,0xb0,0x0b,0xb5,0xb2,0x6e,0x2a,
+0x5a,0x26,0x4a,0xb3,0x37,0x44,0x7b,0xf3,0x31,0xc7,0x7a,0x55,
+0x50,0xad,0x66,0xa5,0xc8,0x86,0x20,0xba,0xf9,0xda,0x1e,0x87,
+0x4b,0x4a,0x3a,0xfa,0xf8,0x0e,0x57,0xee,0x84,0x71,0x24,0x71,
+0x97,0x8d,0x25,0xc0,0x64,0xde,0x9e,0x09,0x2c,0xcf,0x49,0x4a,
+0xbb,0x3c,0xd1,0x1e,0xb1,0x39,0xbb,0x20,0x5a,0xcb,0xaa,0x0a,
+0x98,0x34,0x77,0x4e,0xd3,0xac,0xb2,0x05,0x30,0x03,0x0c,0x2a,
+0x26,0x45,0x46,0xd2,0xc2,0x1a,0x74,0x3a,0xbb,0xdd,0x6a,0x58,
+0x1b,0xe2,0xd2,0x9e,0xe4,0x3a,0x15,0xdd,0xbe,0xfd,0xfa,0x68,
+0xdb,0x85,0x6b,0x6c,0xee,0xc0,0x8e,0x09,0x74,0xdd,0xad,0xbd,
+0xa5,0xce,0x54,0x1d,0x0c,0xb0,0x83,0x61,0xcf,0x32,0x6f,0xf8,
+0x3a,0xda,0x94,0xce,0xd7,0x3d,0x64,0xe0,0x26,0x30,0xa5,0x72,
+0x34,0x01,0x7e,0xa0,0x99,0x0e,0x3e,0x26,0x20,0xdc,0xe5,0xf9,
+0x44,0xcb,0xc5,0x16,0xf0,0x8c,0xcc,0x60,0xe9,0x7a,0xf9,0xbd,
+0x66,0x18,0x86,0x7e,0x22,0x6e,0xc6,0x98,0x17,0x7d,0xfe,0x1c,
+0x22,0xa7,0xff,0xf7,0xdf,0xfd,0xdb,0x16,0x6f,0x08,0xec,0xb3,
+0x16,0x6d,0x5a,0xc0,0x95,0xad,0x01,0xfc,0x99,0xe6,0xee,0xca,
+0xeb,0x09,0x2d,0xdd,0x0d,0xc0,0x49,0x9f,0x02,0xb7,0x31,0xb8,
+0xf3,0x70,0x4e,0x94,0x22,0x63,0xf8,0xd2,0x19,0x7a,0x6d,0xab,
+0x57,0x9c,0x44,0x9b,0x8e,0x03,0x3f,0x85,0x1d,0xe4,0xdc,0xb5,
+0xdb,0x03,0x33,0xc4,0xeb,0x7f,0x84,0x9d,0xfe,0x8c,0x6f,0x1f,
+0x97,0xc6,0x9b,0x5e,0x9b,0xaf,0xdd,0xa4,0x08,0x47,0xf0,0xd8,
+0xbe,0x3b,0x32,0x1c,0x75,0x6c,0x22,0x5c,0xba,0xe7,0x48,0x38,
+0xfa,0x54,0x6b,0x6d,0x36,0xa4,0x9e,0xa6,0x6a,0x3f,0x03,0x95,
+0x63,0xf3,0xf8,0x02,0xc2,0xc8,0xde,0xb8,0xcd,0xc8,0x39,0xee,
+0x5e,0x08,0x6d,0x29,0x6e,0xac,0x3c,0x15,0xca,0x26,0x38,0x90,
+0xf4,0xaf,0x95,0xf3,0x1d,0xa2,0x78,0x28,0xe7,0xdb,0x7e,0xd2,
+0xa3,0x17,0xb5,0x4e,0x21,0x5b,0x30,0x6d,0xb8,0x9d,0xac,0x6c,
+0x71,0xfc,0x8f,0x5e,0xea,0x1b,0xef,0xf4,0x23,0x4c,0xfb,0xeb,
+0xd2,0xec,0xc3,0xbc,0x03,0xa7,0xdf,0x25,0x37,0xdc,0x46,0x84,
+0xf7,0xe6,0xe5,0x56,0xf8,0xa3,0xc2,0x31,0xc6,0x30,0x1d,0xcf,
+0x54,0xfe,0x30,0x1d,0x2f,0x4e,0xa6,0x47,0x6b,0x38,0x5a,0x8f,
+0x87,0x8b,0x93,0xe9,0x78,0xe8,0x16,0x29,0xca,0x69,0x8f,0x75,
+0x3e,0xee,0x46,0x4b,0x46,0xa9,0x0e,0x46,0x13,0x1a,0xbe,0x57,
+0x8e,0x31,0x78,0x07,0xb7,0x92,0xd7,0x2b,0x97,0xcf,0x95,0x4a,
+0xb3,0x61,0x10,0xae,0x75,0x91,0x64,0xfc,0x94,0x44,0xd0,0x1b,
+0xbe,0xae,0x27,0xe3,0x23,0x38,0x9a,0x4e,0x9a,0xa6,0x37,0x84,
+0xde,0xb7,0x9f,0x83,0x04,0xcd,0x06,0x9e,0x83,0x55,0xbd,0xd7,
+0xa2,0xff,0x96,0xe1,0x85,0xdd,0xa7,0x87,0x5d,0x4d,0xe4,0x68,
+0xee,0xcf,0xf4,0x62,0x65,0x6c,0xb4,0xcd,0xd6,0xbc,0x74,0x8f,
+0xae,0xed,0x51,0xb5,0xf0,0x2f,0x25,0x36,0x4c,0x0d,0xbf,0x8a,
+0x18,0xb8,0xbe,0x69,0x1b,0xb5,0xfb,0xbf,0x4f,0xa0,0x5a,0x12,
+0x40,0x05,0xed,0xe4,0x13,0x08,0x13,0x40,0x32,0xd1,0x24,0x41,
+0x47,0xc4,0xfe,0x0c,0xc6,0x86,0xd3,0xc7,0x17,0x10,0xf9,0x24,
+0xe4,0x73,0xe5,0x3e,0x0b,0x1c,0xb7,0x3e,0x81,0xb1,0x0f,0x25,
+0x9f,0x10,0x80,0xc7,0xac,0x14,0x73,0x39,0x82,0x92,0x17,0xf6,
+0x14,0x96,0x4c,0xcf,0x85,0x1c,0xc1,0xff,0xf3,0xe5,0x29,0x54,
+0x2c,0xc7,0x0f,0x74,0x23,0x38,0xc1,0x5f,0x33,0x96,0xdd,0xce,
+0xb5,0x5a,0xc9,0x7c,0x04,0xdf,0x14,0x45,0x71,0x0a,0x85,0x92,
+0x76,0x04,0x27,0xaf,0xaa,0x7b,0x58,0x2a,0xa9,0x4c,0xc5,0x32,
+0x7e,0x0a,0x99,0x2a,0x95,0x1e,0xc1,0x37,0xaf,0x5e,0xbd,0x3a,
+0x25,0x16,0x7b,0x6c,0x16,0x2c,0x57,0x77,0x23,0x90,0x4a,0xf2,
+0x70,0x1a,0x0c,0xc6,0xd5,0x74,0x6c,0xac,0x56,0x72,0x3e,0xad,
+0xeb,0xf4,0x82,0xdb,0x85,0xca,0x9b,0x06,0xea,0x3a,0xfd,0x70,
+0x79,0xde,0x34,0xe3,0xa1,0xdf,0x1c,0x0f,0xab,0x29,0x7e,0x9a,
+0xa1,0x54,0xb8,0x67,0x51,0xd3,0x8c,0xab,0xd6,0xfb,0xf6,0xa0,
+0xec,0xe5,0xcb,0x10,0x0d,0xa1,0x66,0x35,0xad,0x6b,0x2e,0x73,
+0xfc,0xe4,0x33,0xb6,0x6c,0x56,0xf2,0x69,0x30,0x18,0xd4,0xb5,
+0x7b,0xb6,0x7d,0x2b,0xd9,0x92,0x27,0xf0,0xad,0x87,0x7a,0x34,
+0x01,0xcf,0xcc,0x4c,0xd3,0x8c,0xad,0x9e,0x8e,0xed,0xe2,0x10,
+0x32,0x5a,0xcc,0x17,0xb6,0x43,0xe3,0x98,0x7e,0x12,0x26,0x78,
+0x2a,0xd9,0xc4,0x93,0xed,0x62,0x3a,0xb6,0xf9,0xb4,0xae,0x31,
+0xa9,0xed,0x19,0xb4,0x91,0x4f,0xc7,0x43,0xab,0x7b,0x8e,0x0d,
+0x5b,0xcf,0xda,0xd0,0x5e,0x53,0xb1,0x63,0x6c,0x9a,0x77,0x91,
+0x68,0xde,0xa9,0x8c,0x87,0x3e,0x9f,0xd3,0xe0,0xcf,0x38,0xf6,
+0x4c,0x8f,0x46,0xfd,0x9b,0x96,0xde,0xf8,0xcf,0x7a,0xed,0x25,
+0xf3,0x14,0x4f,0xb7,0x84,0xa0,0xa5,0x3a,0x5b,0x3c,0x07,0x69,
+0x0d,0xda,0xea,0xd8,0xa1,0x1f,0x4d,0x58,0x76,0x2d,0xa3,0x4a,
+0x40,0x14,0xc0,0xe4,0x43,0x47,0x7a,0xfa,0xc7,0x1e,0xe4,0x1e,
+0x5c,0xfb,0xcf,0x9b,0x7b,0xd4,0xe3,0xf0,0x38,0x22,0x7e,0xc3,
+0x2c,0xc3,0x7c,0x3c,0x32,0xb0,0xf0,0x55,0xe3,0xca,0x24,0x1c,
+0x81,0xf6,0x15,0x93,0xe0,0xea,0x87,0xcb,0x73,0x5a,0xfa,0x70,
+0x79,0xde,0x7a,0xf0,0xe1,0xf2,0x6d,0x14,0xd3,0xa6,0x4f,0x2e,
+0x09,0xb8,0xbf,0x69,0xd9,0xa1,0x1d,0x8e,0xbc,0x7b,0x09,0xbd,
+0x47,0xf6,0x3b,0x28,0xfa,0x74,0x1d,0x52,0xd5,0x85,0x37,0x40,
+0x7c,0xc8,0xd5,0x60,0x14,0xf7,0xdf,0xbd,0x28,0xd6,0xbe,0x2c,
+0xb6,0x87,0x1f,0xf6,0x15,0xb3,0x95,0x15,0xab,0x80,0xf5,0xf8,
+0xb6,0xc5,0xa4,0x08,0x03,0x4c,0x82,0x9a,0xfd,0xc5,0x33,0x9b,
+0xc0,0x0a,0xf1,0x47,0x5b,0x5d,0xff,0x68,0x1f,0x1d,0x07,0x06,
+0x2b,0xb4,0x54,0xee,0xcb,0xd2,0xe0,0xb4,0x30,0x46,0xec,0x6a,
+0xee,0xe4,0x47,0x90,0x27,0x5c,0x88,0x72,0x7c,0x90,0x4b,0x4f,
+0x3a,0x5c,0x55,0x3c,0x77,0x7a,0xf1,0x81,0x77,0x1e,0x0a,0x10,
+0x42,0x4e,0xe4,0xba,0x0b,0xe5,0x86,0x3e,0xf7,0x6d,0x17,0xd0,
+0x6e,0xbb,0xc7,0x41,0xf1,0xd8,0x7b,0xf4,0xad,0xcc,0xb9,0xb4,
+0x51,0x8b,0x15,0x12,0xc8,0xf0,0xdf,0x36,0xdc,0x9f,0xd8,0xfd,
+0xd4,0xf4,0x52,0x45,0x8e,0x6d,0x52,0xb5,0x97,0xa6,0x9d,0x3b,
+0x85,0xd9,0x92,0x9b,0x97,0x4d,0x02,0x33,0x5e,0x28,0xcd,0xc1,
+0x4f,0xc7,0x5e,0x62,0xb6,0x92,0xd2,0xf2,0xea,0x2f,0x4b,0xca,
+0xa6,0xdb,0x1f,0xe6,0x0c,0x3b,0xc3,0x67,0xe7,0x85,0xfc,0x69,
+0x54,0x1d,0xac,0x02,0xe1,0x6c,0x47,0xc8,0x39,0x33,0x16,0xe1,
+0xbc,0xf7,0xe9,0x0c,0x7d,0x50,0x08,0xa6,0x80,0xe9,0x04,0x5e,
+0xee,0x66,0xf4,0x7a,0x24,0x6e,0xe0,0xc5,0xd6,0x5c,0x87,0x17,
+0x6e,0x47,0x8c,0x6e,0x76,0x61,0xde,0x91,0x0c,0x9a,0xe0,0x7f,
+0x03,0x00,0x32,0x9c,0xf2,0x67,0x11,0x1a,0x00,0x00,
+	}))
+
+	if err != nil {
+		panic("Decompression failed: " + err.Error())
+	}
+
+	var b bytes.Buffer
+	io.Copy(&b, gz)
+	gz.Close()
+
+	return b.Bytes()
+}
\ No newline at end of file
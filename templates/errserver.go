@@ -1,3 +1,5 @@
+// Code generated by eg templates generate. DO NOT EDIT.
+
 package templates
 
 import (
@@ -6,50 +8,51 @@ import (
 	"io"
 )
 
-// ErrServer returns the raw, uncompressed file data data.
-func ErrServer() []byte {
+// Errserver returns the raw, uncompressed contents of errserver.go.mustache.
+func Errserver() []byte {
 	gz, err := gzip.NewReader(bytes.NewBuffer([]byte{
-0x1f,0x8b,0x08,0x00,0x00,0x09,0x6e,0x88,0x00,0xff,0x6c,0x52,
-0xd1,0x6e,0xd3,0x30,0x14,0x7d,0xae,0xbf,0xe2,0x92,0xbd,0xa4,
-0x28,0x6d,0xda,0x14,0x2a,0x94,0x76,0x95,0xa6,0x49,0xd3,0x90,
-0x06,0x9a,0xd8,0x03,0xaf,0xf3,0xe2,0x9b,0xd6,0xc2,0xb1,0x83,
-0xed,0x76,0x2b,0x56,0xfe,0x9d,0xeb,0x34,0x1d,0x20,0xa6,0x3c,
-0xd8,0xbe,0xe7,0xdc,0x73,0x9c,0xe3,0xdb,0xf2,0xea,0x07,0xdf,
-0x22,0x34,0x5c,0x6a,0xc6,0x64,0xd3,0x1a,0xeb,0x21,0x65,0xa3,
-0x44,0xa3,0xcf,0x77,0xde,0xb7,0x09,0xed,0xa5,0x49,0xd8,0x98,
-0xb1,0x03,0xb7,0x80,0xd6,0x1a,0x7b,0x1f,0x3b,0x2e,0xe1,0x71,
-0xfd,0x4e,0x98,0xca,0x1f,0x5b,0x84,0x9d,0x6f,0xd4,0x86,0xad,
-0xcf,0x0b,0x72,0x41,0x8b,0x97,0x5e,0xe1,0xe6,0xda,0x34,0xad,
-0x54,0xdc,0x4b,0xa3,0x4f,0xdd,0x30,0x01,0xdc,0x9a,0x75,0x7e,
-0x82,0xd9,0xda,0xf9,0xa3,0x42,0x88,0x32,0x97,0x89,0xc7,0x17,
-0x9f,0x57,0xce,0x25,0x1b,0xf6,0x64,0xc4,0x11,0x42,0xc3,0xed,
-0x56,0xea,0x72,0xb6,0x6a,0xb9,0x10,0x52,0x6f,0x69,0x57,0x1b,
-0xed,0x27,0x35,0x6f,0xa4,0x3a,0x96,0xc9,0x2d,0xaa,0x03,0x7a,
-0x59,0x71,0xf8,0x8a,0x7b,0x4c,0xb2,0xd7,0x73,0x76,0x65,0x25,
-0x57,0x99,0xe3,0xda,0x4d,0x1c,0x5a,0x59,0xaf,0x3a,0xb6,0x9b,
-0x67,0xbb,0x22,0xdb,0x2d,0xde,0x90,0x2d,0x66,0xed,0x4b,0xcf,
-0x80,0xf0,0x44,0x91,0x6c,0xad,0xd9,0x6b,0x51,0x5e,0x20,0xe2,
-0xaa,0x32,0xca,0xd8,0xf2,0x62,0xb9,0x5c,0x46,0x42,0xf1,0x2f,
-0x41,0x7c,0x5a,0x2c,0x67,0xb3,0x33,0xa7,0xae,0x6b,0xa2,0x90,
-0xfe,0x70,0x2e,0x8a,0x82,0x7a,0x8c,0x82,0xd0,0x5f,0xda,0xc9,
-0x5f,0x58,0xce,0x97,0xe4,0xf4,0xfa,0x37,0x10,0xbf,0x8f,0xd1,
-0x7c,0xb8,0x12,0xcc,0xa8,0x63,0x4a,0x41,0x9d,0x45,0xe0,0xec,
-0xf1,0xb7,0x6d,0x5d,0x8b,0x45,0xf5,0x81,0x98,0xeb,0xbc,0x8f,
-0x8f,0x62,0xcc,0x87,0xd4,0x63,0x6e,0xf1,0x0d,0xe6,0xff,0x27,
-0x4f,0x9c,0x79,0x84,0x8a,0x4d,0x08,0x5f,0xd0,0x39,0x7a,0xc6,
-0xae,0xa3,0x62,0x11,0x8b,0x8b,0xcd,0x67,0x0d,0x21,0xdc,0x48,
-0x85,0x9a,0x37,0x04,0x00,0xf7,0xa0,0xa4,0x46,0x2a,0xde,0xd1,
-0xd2,0x75,0x25,0x51,0x17,0x44,0x6d,0x2d,0x92,0x40,0xb8,0x36,
-0x82,0x8a,0xd4,0x1f,0xcf,0xe4,0x3f,0x18,0xe7,0xa7,0x21,0x78,
-0x64,0xac,0xde,0xeb,0xaa,0x1f,0xac,0x74,0x0c,0x81,0x8d,0xe2,
-0x34,0x4d,0x6f,0xb9,0x16,0x0a,0x6f,0x08,0x49,0x93,0x3c,0xc9,
-0x20,0x72,0xd2,0x67,0xe8,0xb1,0x6f,0xe8,0x5a,0xa3,0x1d,0x7e,
-0xb7,0xd2,0xa3,0xcd,0xc0,0xc2,0xfb,0xa1,0xfe,0x73,0x8f,0xce,
-0xf7,0x22,0x23,0x69,0xa6,0x3d,0xfe,0xe0,0x2d,0x05,0x98,0x3e,
-0x67,0x7f,0x46,0x72,0xcc,0x46,0xdd,0x78,0xf0,0xb9,0x93,0xce,
-0xa3,0xbe,0xd2,0xe2,0x01,0xed,0x01,0xd3,0x24,0x84,0x7b,0x9a,
-0xec,0xae,0x23,0x4b,0x2d,0xd5,0x98,0x75,0xbf,0x03,0x00,0x00,
-0xff,0xff,0xb5,0xde,0x1c,0xe0,0xf9,0x02,0x00,0x00,
+0x1f,0x8b,0x08,0x00,0x00,0x00,0x00,0x00,0x02,0xff,0x6c,0x52,
+0xc1,0x6e,0x9c,0x30,0x14,0x3c,0xef,0xfb,0x8a,0x57,0x72,0xd9,
+0xad,0x48,0xd8,0x65,0xdb,0x55,0x05,0x0e,0x52,0x14,0x29,0x4a,
+0xa5,0xb4,0x8a,0x9a,0x43,0xaf,0x71,0xe0,0x01,0x56,0x8c,0x4d,
+0x6d,0x6f,0x92,0xad,0xe5,0x7f,0xaf,0x4c,0xd8,0xa6,0x55,0x2b,
+0x0e,0xe8,0x79,0xe6,0xcd,0x98,0x61,0x46,0x5e,0x3f,0xf2,0x8e,
+0x70,0xe0,0x42,0x01,0x88,0x61,0xd4,0xc6,0xe1,0x12,0x16,0x89,
+0x22,0x97,0xf5,0xce,0x8d,0x09,0x2c,0x12,0xa1,0x13,0x58,0x01,
+0x3c,0x71,0x83,0x64,0x8c,0x36,0xb7,0x71,0xe3,0x1c,0xef,0xd9,
+0xbb,0x46,0xd7,0xee,0x30,0x12,0xf6,0x6e,0x90,0x15,0xb0,0xe3,
+0x8b,0x78,0x53,0x01,0x73,0xc2,0x49,0xaa,0x2e,0xf5,0x30,0x0a,
+0xc9,0x9d,0xd0,0xea,0x75,0x1b,0x4f,0x91,0x3a,0xcd,0xb2,0x57,
+0x18,0x98,0x75,0x07,0x49,0x18,0x65,0xce,0x13,0x47,0x2f,0x2e,
+0xab,0xad,0x4d,0x2a,0x78,0xd0,0xcd,0x01,0xfd,0xc0,0x4d,0x27,
+0x54,0xb1,0x2e,0x47,0xde,0x34,0x42,0x75,0xc5,0xba,0x6c,0xb5,
+0x72,0xa7,0x2d,0x1f,0x84,0x3c,0x14,0xc9,0x35,0xc9,0x27,0x72,
+0xa2,0xe6,0xf8,0x95,0xf6,0x94,0xa4,0xbf,0xe7,0xf4,0xc2,0x08,
+0x2e,0x53,0xcb,0x95,0x3d,0xb5,0x64,0x44,0x5b,0x06,0xe8,0x37,
+0x69,0x9f,0xa7,0xfd,0xf6,0x3f,0xb2,0xf9,0x7a,0x7c,0x99,0x18,
+0xe8,0x1f,0x78,0xfd,0xd8,0x19,0xbd,0x57,0x4d,0x71,0x42,0x44,
+0x65,0xad,0xa5,0x36,0xc5,0xc9,0x6e,0xb7,0x8b,0x84,0xfc,0x6f,
+0x42,0xf3,0x69,0xbb,0x5b,0xaf,0x8f,0x9c,0xb6,0x6d,0x03,0x44,
+0xfd,0x79,0xce,0xf3,0xbc,0x0c,0xa0,0x25,0xfa,0xe9,0xd2,0x56,
+0xfc,0xa4,0x62,0xb3,0x1b,0x5f,0xde,0xbe,0x06,0xe3,0xf3,0x31,
+0x9a,0xcf,0x57,0xc2,0x75,0x19,0xe0,0x8c,0x8c,0x39,0x8a,0xe0,
+0xd1,0xe3,0x4f,0xdb,0xb6,0x6d,0xb6,0xf5,0x87,0x32,0x00,0xcb,
+0xa6,0xf8,0x2a,0x60,0xd9,0x9c,0x7a,0xcc,0x2d,0xfe,0x83,0xcd,
+0xbf,0xc9,0xb3,0xac,0xdf,0x44,0x28,0xaf,0xbc,0xff,0x42,0xd6,
+0xf2,0x8e,0x42,0x60,0x59,0x9f,0xc7,0xc3,0x6d,0xf5,0x59,0xa1,
+0xf7,0x57,0x42,0x92,0xe2,0x03,0x85,0x80,0xdc,0xa1,0x14,0x8a,
+0xd0,0xfb,0x1b,0xa1,0x28,0x84,0x82,0x65,0xfd,0xb6,0x02,0x36,
+0x1a,0xaa,0xbc,0xf7,0x97,0xba,0xa1,0x10,0xf7,0xe3,0x0c,0x2c,
+0x9b,0x8d,0xb3,0xd7,0x12,0xdc,0x03,0xb4,0x7b,0x55,0x4f,0xc5,
+0x5a,0xae,0xd0,0xc3,0x22,0xb6,0xe9,0xec,0x9a,0xab,0x46,0xd2,
+0xd5,0x5e,0xd5,0xcb,0x24,0x4b,0x52,0x8c,0x9c,0xe5,0x33,0x4e,
+0xd8,0x37,0xb2,0xa3,0x56,0x96,0xbe,0x1b,0xe1,0xc8,0xa4,0x68,
+0xf0,0xfd,0x7c,0xfe,0x63,0x4f,0xd6,0x4d,0x22,0x0b,0xa1,0xcf,
+0x26,0xfc,0xce,0x19,0xa1,0xba,0xe5,0x73,0xfa,0x56,0xc9,0x15,
+0x2c,0xc2,0x6a,0xf6,0xb9,0x11,0xd6,0x91,0xba,0x50,0xcd,0x1d,
+0x99,0x27,0x5a,0x26,0xde,0xdf,0x6a,0xe3,0x42,0x48,0x52,0x54,
+0x42,0xae,0x20,0xfc,0x1a,0x00,0xb5,0xde,0x1c,0xe0,0xf9,0x02,
+0x00,0x00,
 	}))
 
 	if err != nil {
@@ -0,0 +1,80 @@
+// Code generated by eg templates generate. DO NOT EDIT.
+
+package templates
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+)
+
+// FaultsHTML returns the raw, uncompressed contents of faults.html.mustache.
+func FaultsHTML() []byte {
+	gz, err := gzip.NewReader(bytes.NewBuffer([]byte{
+0x1f,0x8b,0x08,0x00,0x00,0x00,0x00,0x00,0x02,0xff,0x9c,0x54,
+0x4d,0x6f,0xdb,0x38,0x10,0xbd,0xeb,0x57,0x70,0x19,0xec,0x25,
+0x88,0x43,0x29,0xbb,0x6b,0x60,0x65,0x8a,0x40,0xd2,0x0f,0xb4,
+0x45,0x1b,0x14,0xed,0xb1,0x68,0x0b,0x4a,0x1c,0x5b,0x4c,0x28,
+0x92,0x20,0xa9,0xd4,0xae,0xa0,0xff,0x5e,0x88,0x92,0x9d,0xc4,
+0x8e,0x83,0xa2,0x17,0x1b,0xe4,0xcc,0x7b,0xf3,0x86,0x6f,0x46,
+0xf4,0x2f,0x61,0xaa,0xb0,0xb1,0x80,0xea,0xd0,0x28,0x96,0xd0,
+0xed,0x1f,0x70,0xc1,0x12,0x1a,0x64,0x50,0xc0,0x5e,0xf3,0x56,
+0x05,0x8f,0x66,0x08,0x56,0x86,0x92,0xf1,0x2e,0xa1,0x3e,0x6c,
+0x14,0xa0,0x01,0x5b,0xe0,0x00,0xeb,0x40,0x2a,0xef,0x31,0x4b,
+0x4a,0x23,0x36,0xa8,0x6b,0xb8,0x5b,0x49,0x9d,0xa7,0x0b,0xcb,
+0x85,0x90,0x7a,0x95,0xa7,0x8b,0xa5,0xd1,0x61,0xb6,0xe4,0x8d,
+0x54,0x9b,0x1c,0xbf,0x01,0x75,0x07,0x41,0x56,0x1c,0x5d,0x43,
+0x0b,0xf8,0x6c,0x77,0x3e,0xbb,0x74,0x92,0xab,0x33,0xcf,0xb5,
+0x9f,0x79,0x70,0x72,0xb9,0xe8,0x93,0x3a,0x7b,0x82,0xf0,0x22,
+0xb5,0xeb,0x45,0xc9,0xab,0xdb,0x95,0x33,0xad,0x16,0xf9,0x09,
+0x00,0x2c,0x2a,0xa3,0x8c,0xcb,0x4f,0xe6,0xf3,0xf9,0xa2,0x4f,
+0x5a,0x85,0x3a,0x25,0x7d,0x98,0x45,0xa5,0xb9,0x36,0x1a,0x16,
+0x4f,0xe8,0xea,0x13,0x25,0x51,0xb7,0x3d,0xcf,0xed,0x1a,0x8d,
+0xd4,0xc6,0x09,0x70,0xb3,0xd2,0x84,0x60,0x9a,0x3c,0xb3,0x6b,
+0xe4,0x8d,0x92,0x02,0xc5,0x3a,0x11,0xb3,0x34,0xae,0x41,0x9d,
+0x90,0xde,0x2a,0xbe,0xc9,0xa5,0x56,0x52,0x0f,0x11,0x7b,0x4f,
+0x96,0x8e,0x54,0x8f,0x54,0x0d,0xa8,0x73,0x2e,0xc4,0x7d,0x56,
+0xcc,0x79,0x10,0x50,0xbc,0x04,0xb5,0x4f,0x3c,0x2b,0x95,0xa9,
+0x6e,0x77,0xfa,0x51,0x96,0xda,0xf5,0xf8,0x33,0xbd,0xac,0x97,
+0x3f,0x21,0xcf,0xfe,0x8d,0x54,0x52,0xdb,0x36,0x7c,0x89,0xd6,
+0x0c,0xce,0x7c,0x45,0xdd,0x0f,0x29,0x42,0x9d,0xff,0x3f,0x56,
+0xa2,0x24,0xbe,0x08,0x4b,0x28,0x99,0x7c,0x1e,0x4c,0x1b,0x5c,
+0xcf,0x26,0xaf,0x29,0xa9,0x33,0x96,0xd0,0x56,0xb1,0xa4,0xeb,
+0x4e,0x3e,0xb5,0x0a,0x7c,0xdf,0x27,0x54,0x49,0xd6,0x75,0xc3,
+0xa9,0xef,0x11,0x8d,0xed,0x37,0x10,0x6a,0x23,0x0a,0x6c,0x8d,
+0x0f,0x18,0xf1,0x2a,0x48,0xa3,0x0b,0x4c,0xbe,0xc3,0x8a,0x2c,
+0x23,0x13,0x66,0x34,0xaa,0x99,0x06,0xa5,0x96,0x42,0x80,0xc6,
+0x48,0xf3,0x06,0x0a,0xec,0xa0,0x31,0x77,0x80,0xd1,0x1d,0x57,
+0x2d,0x14,0xb8,0xeb,0xde,0x6a,0x01,0xeb,0xbe,0xc7,0x8c,0x96,
+0x6d,0x08,0x46,0xb3,0x31,0x83,0x92,0xe9,0x48,0xc9,0x50,0x95,
+0x51,0xa2,0xe4,0xa0,0x8c,0xec,0x94,0x91,0x51,0xea,0xb7,0xe9,
+0x82,0x5a,0x76,0x6d,0xd0,0xa8,0x00,0x71,0x07,0xa8,0x04,0xa9,
+0x57,0x48,0xea,0x1b,0xa8,0x02,0x88,0x73,0x4a,0x2c,0x7b,0x08,
+0x8f,0xbd,0x54,0x8a,0x7b,0x5f,0x60,0x2e,0x04,0xfe,0x9d,0xbe,
+0x12,0x1a,0x8d,0x62,0x1f,0x79,0xa8,0xd1,0xa3,0x26,0x87,0x37,
+0xdf,0xb6,0x68,0x79,0xa8,0x77,0x0d,0x92,0xd3,0x53,0x3c,0x88,
+0x8f,0xb8,0x2d,0xfe,0x43,0x2c,0x75,0x9c,0x61,0x94,0x72,0x88,
+0x7b,0xcf,0x03,0xe8,0x6a,0x73,0x1c,0xa8,0xc6,0x04,0x8c,0xac,
+0xe2,0x15,0xd4,0x46,0x09,0x70,0x05,0xbe,0x48,0xd3,0xc6,0x1f,
+0xb2,0xbd,0x93,0x21,0x80,0x3b,0x4e,0x76,0x13,0xe3,0x7b,0x5c,
+0xd9,0xd3,0x5c,0xaf,0x9c,0x33,0xce,0xa3,0xbf,0x8f,0xb3,0x41,
+0xcc,0x38,0x44,0x7e,0x0e,0x3c,0xb4,0xfe,0x38,0xce,0xc7,0xf8,
+0x9e,0x8a,0xff,0xd2,0x7f,0x0e,0x99,0x5e,0x3a,0x63,0x2d,0x88,
+0xe7,0x44,0x08,0x67,0xec,0x21,0xf0,0x8a,0x6b,0x11,0xb7,0x05,
+0x5d,0x91,0x67,0x94,0x94,0xdb,0xb4,0x87,0x0c,0xd3,0x94,0x5e,
+0x0a,0xb1,0x9b,0xd8,0x64,0x1a,0xd9,0x3f,0x9b,0xb1,0x67,0x76,
+0xa7,0x52,0xc0,0xdd,0x6e,0xb2,0xb2,0xfb,0x95,0x79,0x31,0x04,
+0x10,0x57,0x6a,0x7f,0x6b,0x12,0x4a,0xa6,0x3d,0x27,0xe3,0x57,
+0xfe,0xd7,0x00,0x9c,0xc4,0xe3,0x8a,0xfd,0x05,0x00,0x00,
+	}))
+
+	if err != nil {
+		panic("Decompression failed: " + err.Error())
+	}
+
+	var b bytes.Buffer
+	io.Copy(&b, gz)
+	gz.Close()
+
+	return b.Bytes()
+}
\ No newline at end of file
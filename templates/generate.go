@@ -0,0 +1,241 @@
+package templates
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// header marks the files written by Generate. Files without it are never
+// overwritten or removed.
+const header = "// Code generated by eg templates generate. DO NOT EDIT.\n"
+
+// byteRegexp matches the bytes of the gzip literal in a generated accessor,
+// and literalRegexp matches the whole literal.
+var byteRegexp = regexp.MustCompile(`0x([0-9a-f]{2}),`)
+var literalRegexp = regexp.MustCompile(`0x[0-9a-f]{2},(\n?0x[0-9a-f]{2},)*`)
+
+// initialisms are name pieces that are upper-cased whole in accessor names.
+var initialisms = map[string]string{
+	"db":   "DB",
+	"html": "HTML",
+	"json": "JSON",
+}
+
+// source describes one template file and the Go file embedding it.
+type source struct {
+	Name     string // e.g. "db.json.mustache"
+	Accessor string // e.g. "DBJSON"
+	File     string // e.g. "db_json.go"
+}
+
+func newSource(name string) source {
+	base := strings.TrimSuffix(name, ".mustache")
+	base = strings.TrimSuffix(base, ".go")
+	pieces := strings.FieldsFunc(base, func(r rune) bool {
+		return r == '.' || r == '_' || r == '-'
+	})
+	accessor := ""
+	for _, piece := range pieces {
+		if up, ok := initialisms[strings.ToLower(piece)]; ok {
+			accessor += up
+		} else {
+			accessor += strings.ToUpper(piece[0:1]) + piece[1:]
+		}
+	}
+	return source{
+		Name:     name,
+		Accessor: accessor,
+		File:     strings.Join(pieces, "_") + ".go",
+	}
+}
+
+// render returns the generated Go files for the templates in src, keyed by
+// file name.
+func render(src string) (map[string][]byte, error) {
+	dirlist, err := ioutil.ReadDir(src)
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string][]byte)
+	sources := make([]source, 0)
+	for _, f := range dirlist {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), ".mustache") {
+			continue
+		}
+		s := newSource(f.Name())
+		if _, ok := files[s.File]; ok {
+			return nil, fmt.Errorf("templates: %s and another template both map to %s", s.Name, s.File)
+		}
+		data, err := ioutil.ReadFile(filepath.Join(src, f.Name()))
+		if err != nil {
+			return nil, err
+		}
+		files[s.File] = renderAccessor(s, data)
+		sources = append(sources, s)
+	}
+
+	var b bytes.Buffer
+	b.WriteString(header + "\npackage templates\n\n")
+	b.WriteString("// defaults maps template names (the file names in templates/mustache) to the\n")
+	b.WriteString("// embedded data that is used when no override exists.\n")
+	b.WriteString("var defaults = map[string]func() []byte{\n")
+	for _, s := range sources {
+		fmt.Fprintf(&b, "\t%q: %s,\n", s.Name, s.Accessor)
+	}
+	b.WriteString("}\n")
+	if _, ok := files["defaults.go"]; ok {
+		return nil, fmt.Errorf("templates: a template maps to defaults.go")
+	}
+	files["defaults.go"], err = format.Source(b.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+func renderAccessor(s source, data []byte) []byte {
+	var gz bytes.Buffer
+	w, _ := gzip.NewWriterLevel(&gz, gzip.BestCompression)
+	w.Write(data)
+	w.Close()
+
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("\npackage templates\n\nimport (\n\t\"bytes\"\n\t\"compress/gzip\"\n\t\"io\"\n)\n\n")
+	fmt.Fprintf(&b, "// %s returns the raw, uncompressed contents of %s.\n", s.Accessor, s.Name)
+	fmt.Fprintf(&b, "func %s() []byte {\n", s.Accessor)
+	b.WriteString("\tgz, err := gzip.NewReader(bytes.NewBuffer([]byte{\n")
+	for i, c := range gz.Bytes() {
+		if i > 0 && i%12 == 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "0x%02x,", c)
+	}
+	b.WriteString("\n\t}))\n\n")
+	b.WriteString("\tif err != nil {\n\t\tpanic(\"Decompression failed: \" + err.Error())\n\t}\n\n")
+	b.WriteString("\tvar b bytes.Buffer\n\tio.Copy(&b, gz)\n\tgz.Close()\n\n\treturn b.Bytes()\n}")
+	return b.Bytes()
+}
+
+// generated returns the names of the files in out that were written by
+// Generate.
+func generated(out string) ([]string, error) {
+	dirlist, err := ioutil.ReadDir(out)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0)
+	for _, f := range dirlist {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), ".go") {
+			continue
+		}
+		if isGenerated(filepath.Join(out, f.Name())) {
+			names = append(names, f.Name())
+		}
+	}
+	return names, nil
+}
+
+func isGenerated(filename string) bool {
+	data, err := ioutil.ReadFile(filename)
+	return err == nil && bytes.HasPrefix(data, []byte(header))
+}
+
+// Generate writes one Go file with an accessor for every *.mustache file in
+// src, plus the defaults table used by Get, into out. Generated files for
+// templates that no longer exist are removed.
+func Generate(src string, out string) error {
+	files, err := render(src)
+	if err != nil {
+		return err
+	}
+	for name, data := range files {
+		filename := filepath.Join(out, name)
+		if _, err := os.Stat(filename); err == nil && !isGenerated(filename) {
+			return fmt.Errorf("templates: refusing to overwrite %s, it wasn't generated", filename)
+		}
+		if err := ioutil.WriteFile(filename, data, 0666); err != nil {
+			return err
+		}
+	}
+	old, err := generated(out)
+	if err != nil {
+		return err
+	}
+	for _, name := range old {
+		if _, ok := files[name]; !ok {
+			if err := os.Remove(filepath.Join(out, name)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// embedded returns the uncompressed template data in a generated accessor
+// file. Comparing this rather than the file itself keeps Check independent of
+// the compressor's exact output.
+func embedded(data []byte) ([]byte, error) {
+	var gz bytes.Buffer
+	for _, m := range byteRegexp.FindAllSubmatch(data, -1) {
+		var c byte
+		fmt.Sscanf(string(m[1]), "%02x", &c)
+		gz.WriteByte(c)
+	}
+	r, err := gzip.NewReader(&gz)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return ioutil.ReadAll(r)
+}
+
+// Check reports the files in out that Generate would create, change or
+// remove. An empty result means the embedded templates are current.
+func Check(src string, out string) ([]string, error) {
+	files, err := render(src)
+	if err != nil {
+		return nil, err
+	}
+	stale := make([]string, 0)
+	for name, data := range files {
+		current, err := ioutil.ReadFile(filepath.Join(out, name))
+		if err != nil || !sameFile(current, data) {
+			stale = append(stale, name)
+		}
+	}
+	old, err := generated(out)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range old {
+		if _, ok := files[name]; !ok {
+			stale = append(stale, name)
+		}
+	}
+	sort.Strings(stale)
+	return stale, nil
+}
+
+func sameFile(current []byte, want []byte) bool {
+	if bytes.Equal(current, want) {
+		return true
+	}
+	if !bytes.Equal(literalRegexp.ReplaceAll(current, nil), literalRegexp.ReplaceAll(want, nil)) {
+		return false
+	}
+	a, err := embedded(current)
+	if err != nil {
+		return false
+	}
+	b, err := embedded(want)
+	return err == nil && bytes.Equal(a, b)
+}
@@ -0,0 +1,15 @@
+package templates
+
+import (
+	"testing"
+)
+
+func TestGeneratedTemplatesAreCurrent(t *testing.T) {
+	stale, err := Check("mustache", ".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range stale {
+		t.Errorf("templates/%s is out of date, run `go generate ./templates`", name)
+	}
+}
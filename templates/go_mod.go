@@ -0,0 +1,30 @@
+// Code generated by eg templates generate. DO NOT EDIT.
+
+package templates
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+)
+
+// GoMod returns the raw, uncompressed contents of go.mod.mustache.
+func GoMod() []byte {
+	gz, err := gzip.NewReader(bytes.NewBuffer([]byte{
+0x1f,0x8b,0x08,0x00,0x00,0x00,0x00,0x00,0x02,0xff,0xca,0xcd,
+0x4f,0x29,0xcd,0x49,0x55,0xa8,0xae,0xf6,0x05,0x33,0x6a,0x6b,
+0xb9,0xb8,0xd2,0xf3,0x15,0xaa,0xab,0xdd,0xf3,0xc3,0x52,0x8b,
+0x8a,0x33,0xf3,0xf3,0x6a,0x6b,0xb9,0x00,0x03,0x00,0x7c,0x45,
+0x21,0x4b,0x24,0x00,0x00,0x00,
+	}))
+
+	if err != nil {
+		panic("Decompression failed: " + err.Error())
+	}
+
+	var b bytes.Buffer
+	io.Copy(&b, gz)
+	gz.Close()
+
+	return b.Bytes()
+}
\ No newline at end of file
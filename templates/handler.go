@@ -0,0 +1,222 @@
+// Code generated by eg templates generate. DO NOT EDIT.
+
+package templates
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+)
+
+// Handler returns the raw, uncompressed contents of handler.go.mustache.
+func Handler() []byte {
+	gz, err := gzip.NewReader(bytes.NewBuffer([]byte{
+0x1f,0x8b,0x08,0x00,0x00,0x00,0x00,0x00,0x02,0xff,0xac,0x58,
+0x6d,0x6f,0xe3,0xb8,0xf1,0x7f,0x6d,0x7d,0x8a,0x59,0xfd,0xf1,
+0x4f,0xa4,0x44,0xab,0x38,0x87,0x6b,0x0b,0x78,0xcf,0x05,0x72,
+0xbb,0x77,0xdd,0x2b,0x76,0xaf,0x41,0x92,0xb6,0x2f,0x82,0xa0,
+0x60,0xa4,0x91,0xcc,0x46,0x26,0x55,0x92,0x8e,0xcf,0x70,0xfc,
+0xdd,0x8b,0x19,0x52,0x4f,0x49,0xf6,0xae,0x5b,0x14,0x08,0x62,
+0x89,0x1c,0xce,0xc3,0x6f,0x86,0xf3,0xa0,0x56,0x14,0x0f,0xa2,
+0x46,0x58,0x0b,0xa9,0xa2,0x48,0xae,0x5b,0x6d,0x1c,0x24,0xd1,
+0x2c,0xbe,0xdf,0x39,0xb4,0x71,0x34,0x8b,0xab,0xb5,0xa3,0x1f,
+0xa9,0xcf,0xa4,0xde,0x38,0xd9,0xd0,0x4b,0xa3,0x6b,0xfa,0x51,
+0x48,0x5b,0x0a,0xdd,0xca,0xb9,0x16,0xe8,0xf5,0x8c,0x9e,0xc2,
+0x16,0x3f,0xf3,0xbf,0xee,0x18,0x2d,0x6e,0x0c,0x3f,0x6a,0xe6,
+0x6d,0xb0,0x6a,0xb0,0x60,0xfe,0xd6,0x19,0xa9,0x6a,0x5e,0x75,
+0x72,0x8d,0x71,0x94,0x46,0xd1,0xd9,0x19,0x88,0xc2,0x49,0xad,
+0x3e,0xa2,0x28,0xd1,0x80,0x12,0x6b,0xb4,0xe0,0x56,0x18,0x96,
+0x41,0x80,0xc1,0x7f,0x6d,0xd0,0x3a,0xd8,0x0a,0x0b,0x46,0x6f,
+0x1c,0x96,0xe0,0x74,0x06,0x95,0x36,0x4c,0x57,0xe2,0x23,0xb4,
+0x46,0xff,0xb2,0xcb,0xa3,0x42,0x2b,0xeb,0xa6,0xfc,0x96,0x10,
+0xef,0xf7,0x70,0x31,0x5e,0x3a,0x1c,0x62,0x96,0xcb,0xbc,0x40,
+0x5a,0x16,0x51,0x4b,0xeb,0x8c,0x60,0x89,0xba,0x82,0x42,0xab,
+0xea,0x8c,0xf7,0x6d,0x5e,0xeb,0x0c,0x84,0x85,0x4a,0x6f,0x54,
+0x09,0xf7,0x3b,0xc0,0x3a,0x87,0x0b,0x05,0xb8,0x6e,0xdd,0x0e,
+0xd6,0xe8,0x56,0xba,0x24,0x6e,0x6b,0xe1,0x8a,0x15,0x5a,0x10,
+0x6a,0x97,0x47,0x6e,0xd7,0x62,0xe0,0x6f,0x9d,0xd9,0x14,0x0e,
+0xf6,0xd1,0xcc,0xd3,0x82,0x47,0x21,0x9a,0xb5,0xc2,0xad,0x00,
+0xfa,0xd7,0x60,0x6e,0x78,0x3d,0x44,0xd1,0xa3,0x30,0x9e,0x85,
+0x85,0x25,0xdc,0xde,0xf1,0xe3,0x3e,0x9a,0xed,0xf7,0xff,0x77,
+0xc5,0xab,0x87,0x03,0xbd,0xec,0xe1,0x93,0x74,0x68,0x44,0x03,
+0x87,0xc3,0x21,0xa3,0x95,0xb3,0x7e,0xfb,0xc0,0x66,0xde,0x4b,
+0x45,0x56,0x17,0x2b,0x2c,0x1e,0x3c,0xb2,0xad,0x30,0x62,0x8d,
+0x0e,0x8d,0x25,0x5b,0x85,0x0a,0x88,0x65,0x20,0x94,0xdd,0x22,
+0xc9,0x87,0xad,0x74,0x2b,0x10,0xf0,0xed,0x7c,0x0e,0x42,0xb1,
+0x7d,0x06,0xdd,0xc6,0x28,0xda,0x53,0xb2,0x81,0xed,0x0a,0x15,
+0x68,0x85,0x50,0x6a,0xb4,0xea,0xd8,0x11,0x4f,0x8b,0xb9,0x67,
+0x4d,0x98,0x41,0x25,0x9b,0xc6,0x06,0xe1,0x16,0xa4,0x0a,0xa0,
+0xf8,0x05,0xa8,0x36,0xaa,0x48,0xb6,0x10,0xe2,0x2a,0xbf,0x42,
+0xdb,0x6a,0x65,0xf1,0xef,0x86,0x8c,0xc9,0xc0,0xc0,0xc9,0xb0,
+0xc5,0xde,0xcf,0x80,0xf1,0x5a,0x8b,0xf6,0xd6,0x43,0x74,0xe7,
+0x7f,0x52,0x82,0xc6,0x87,0x58,0xfe,0x37,0xd1,0x6c,0xd0,0x03,
+0xd7,0xc9,0x5d,0x8e,0x4f,0xf8,0xc5,0xbd,0x87,0x05,0x8d,0xd1,
+0xe6,0x92,0xee,0x85,0x41,0x4f,0xeb,0xa1,0xa9,0x91,0x23,0x4b,
+0x28,0x4f,0x01,0xd6,0x09,0xb7,0xf1,0x9b,0xa2,0x6d,0x03,0x44,
+0x58,0x32,0x42,0x19,0xd4,0xf2,0x11,0x15,0xb1,0xdb,0xae,0x84,
+0x03,0xe9,0xfa,0x7d,0x02,0xb3,0x0c,0xa0,0x59,0x90,0xce,0x52,
+0x4c,0x39,0x54,0x0e,0x08,0x86,0x1c,0x7e,0x72,0xc7,0x16,0x2c,
+0x3a,0x8e,0x28,0x12,0xc4,0xa0,0x4d,0xb0,0xb6,0x50,0x89,0xc6,
+0x7a,0x75,0xbc,0x16,0x68,0x59,0xac,0xde,0x38,0x10,0xac,0x6a,
+0xce,0xc6,0x0e,0xa6,0x30,0xac,0xaf,0x81,0x17,0xac,0x90,0xca,
+0x75,0x5e,0x86,0x0e,0xbf,0xe4,0xf6,0x8e,0x12,0x41,0x16,0x16,
+0x32,0xb8,0xd7,0xba,0x49,0xa3,0x88,0x78,0x81,0xb4,0x1f,0xf0,
+0x31,0x49,0x79,0x8d,0x62,0x98,0x74,0xf9,0x47,0x06,0xc2,0xd4,
+0xb0,0x58,0x82,0x11,0xaa,0x46,0xd0,0x36,0xbf,0x30,0xb5,0xbd,
+0x3d,0x5f,0xdc,0x11,0xc9,0x4c,0x56,0xbc,0xbf,0x5c,0x42,0xfc,
+0xb6,0xc4,0xc7,0x18,0x9e,0x9e,0x26,0x0b,0x4b,0x67,0x36,0x38,
+0x5d,0x7d,0x49,0x37,0x22,0x24,0x9e,0x33,0x0f,0x09,0xd0,0x4a,
+0x34,0x9b,0x1d,0x22,0xfa,0x0b,0x6b,0x8c,0x52,0x08,0x76,0x8b,
+0xe6,0x11,0xc1,0x6c,0x94,0xf7,0x18,0xd6,0xda,0x2f,0x99,0x1c,
+0x7e,0x52,0x94,0x2a,0x32,0xd8,0xae,0x64,0xb1,0x02,0x69,0x61,
+0xa5,0xb7,0x80,0xf5,0x40,0x2c,0xda,0x36,0x23,0x17,0xf2,0x82,
+0x66,0xaf,0x0a,0x68,0xb4,0x6e,0xef,0x45,0xf1,0x00,0x9c,0x35,
+0xf9,0x5a,0xac,0x84,0x2a,0x1b,0x34,0x20,0x15,0x54,0x46,0x2b,
+0x47,0x97,0x48,0x3a,0xd0,0xaa,0xe3,0x72,0x6c,0x99,0x3a,0x87,
+0xbf,0xb8,0x15,0x9a,0xad,0xb4,0x48,0xbc,0xa6,0xfa,0xf8,0x1f,
+0xfb,0xec,0x08,0x05,0x0a,0x36,0x55,0xee,0xc1,0x67,0x92,0xc4,
+0x6c,0x94,0xf7,0x6b,0x9a,0x12,0x14,0xb2,0x82,0x37,0x9d,0x57,
+0x08,0x19,0xb3,0x51,0x49,0x1a,0x75,0x00,0x31,0x2e,0xcc,0x69,
+0xb1,0x84,0xf8,0x77,0xf3,0xf9,0x3c,0x8e,0x66,0xc2,0xd4,0x96,
+0xde,0xd7,0xe2,0x01,0x93,0xdb,0xbb,0xce,0xcf,0xf3,0x0c,0x1a,
+0x54,0x49,0x70,0x5f,0x9a,0x7a,0xef,0x4a,0xa2,0x9c,0xbf,0x03,
+0x09,0xdf,0x4d,0xb6,0xdf,0x81,0x3c,0x3d,0x65,0x89,0xc1,0xf9,
+0x9d,0xdb,0xe5,0x5d,0x34,0x9b,0x51,0xda,0xa6,0x45,0xcf,0xdb,
+0xe6,0x37,0x46,0xae,0x3f,0x61,0xe5,0x12,0x61,0xea,0x0c,0xe2,
+0xb7,0x31,0xa9,0x68,0xb7,0xd2,0x15,0x2b,0xe6,0x51,0x08,0x8b,
+0x20,0xe1,0x8f,0x30,0x87,0xa3,0xa3,0xfe,0xd4,0x47,0x61,0x2f,
+0x0d,0x56,0xf2,0x97,0xe1,0xd8,0xeb,0xdb,0x24,0x2e,0x83,0x98,
+0x0c,0x5d,0xc6,0xe9,0x82,0x02,0x84,0x9e,0x61,0xaa,0xc0,0xab,
+0xc4,0x5f,0x2b,0x9c,0x2d,0x5b,0x2e,0xfd,0xf9,0x98,0x56,0xe4,
+0xe9,0xf9,0x33,0x6c,0x58,0x01,0x79,0x7a,0x3a,0xd2,0x63,0x82,
+0x4e,0x89,0x95,0xd8,0x34,0x8e,0xc9,0xd8,0x19,0x4b,0xf2,0x39,
+0xaa,0x92,0x44,0x59,0xbe,0x4e,0x69,0x1f,0xd5,0x94,0x9b,0x6a,
+0x0d,0x5a,0x35,0x3b,0x70,0xe2,0x01,0x2d,0xdd,0x75,0x6d,0xe8,
+0x12,0x6b,0x10,0x50,0x19,0x44,0xce,0xbc,0xd2,0x42,0x2b,0x8b,
+0x07,0x2c,0x43,0xa6,0x69,0x50,0x58,0x2c,0x39,0x5b,0x48,0x97,
+0x47,0xb3,0x46,0x65,0x80,0xc6,0x90,0x57,0x14,0xba,0xfc,0x93,
+0xb4,0x0e,0x55,0x12,0xbb,0xa2,0x8d,0x33,0x88,0xcf,0xbf,0xf9,
+0x43,0x3e,0xcf,0xe7,0xf9,0xf9,0x62,0x4e,0x98,0xc8,0x8a,0x69,
+0xdf,0x2c,0x39,0xbb,0x93,0x87,0x1a,0x5d,0xe7,0x3f,0x0a,0x27,
+0x9a,0x04,0x8d,0x49,0x59,0x33,0xa9,0x14,0x32,0xbf,0x46,0xe5,
+0x17,0x65,0x69,0x92,0x34,0x4f,0x28,0xd5,0xe4,0x37,0xef,0x2f,
+0xe9,0x3d,0xcd,0x2f,0xb5,0x71,0x24,0x39,0x7f,0xdf,0x68,0x8b,
+0x14,0x97,0x01,0x87,0xe7,0x16,0x57,0x6b,0x97,0x5f,0xb7,0x46,
+0x2a,0x57,0x25,0xf1,0x5b,0x76,0xcd,0xff,0x3f,0xc6,0x19,0xb0,
+0x08,0x0a,0xc5,0x5a,0x87,0xa0,0x9f,0x04,0xf9,0xa0,0x54,0xfc,
+0xfc,0x3a,0x39,0xdd,0xb6,0x58,0x92,0x2d,0x07,0x22,0x75,0xc2,
+0xd4,0xc8,0xb7,0xe0,0x68,0x63,0x9a,0xfc,0xaf,0x57,0x9f,0xf6,
+0xd7,0xc5,0x0a,0xd7,0xb8,0x80,0x98,0x1b,0x98,0x0c,0x3e,0x6a,
+0xeb,0x16,0x53,0x4d,0x06,0x54,0x46,0xda,0x1c,0xfc,0xa5,0x6b,
+0x18,0x40,0xa9,0xea,0xc4,0xf3,0xce,0xe9,0x7c,0x06,0xe7,0xf3,
+0x13,0x6a,0x66,0xf2,0x6b,0x2c,0xb4,0x2a,0xd3,0x29,0x76,0xd5,
+0x0b,0x3d,0x4b,0x59,0x52,0xb9,0xb4,0x4e,0x18,0x07,0x3d,0x4b,
+0xd0,0x0a,0x58,0xe2,0x88,0xb5,0xc7,0x7c,0xb0,0xb8,0xcb,0xe9,
+0xde,0x91,0x17,0xaa,0xbc,0xe6,0xf4,0x10,0x2f,0xe2,0x53,0x1f,
+0x1e,0x21,0x2f,0x05,0xf5,0xd2,0x34,0x0d,0x39,0x71,0x90,0xb2,
+0x15,0xd2,0x59,0x5f,0x4f,0x34,0x75,0x23,0xb4,0xe6,0x34,0x88,
+0xa2,0xc0,0xd6,0x51,0x81,0x52,0xc8,0x9d,0x80,0x25,0x7d,0x44,
+0x59,0x9a,0x90,0x88,0x06,0xd3,0x69,0xb1,0x2f,0x14,0x64,0x37,
+0xd5,0x22,0xfa,0xcd,0x3f,0x6c,0x7c,0xfb,0x34,0xad,0x15,0xde,
+0xce,0xc5,0xd2,0xd3,0xfc,0xac,0xb7,0x49,0xfa,0xce,0x3f,0x5f,
+0x4b,0x55,0x60,0xc2,0xfb,0x29,0x7c,0xd7,0xf1,0xea,0x36,0x1b,
+0xc4,0x36,0xf9,0x66,0x0e,0x27,0xfe,0xfd,0xb3,0x6c,0x1a,0x69,
+0x47,0x08,0x4b,0xee,0xd1,0xa6,0x11,0xfe,0x41,0x8a,0xa6,0x8b,
+0x6f,0xd2,0x33,0x7d,0xc7,0xbb,0xcb,0x21,0xa6,0x67,0x74,0x66,
+0x88,0xcd,0xff,0xb8,0xaa,0x74,0x19,0xbf,0x15,0xd6,0xa2,0xed,
+0x1a,0x52,0x46,0xc9,0xe9,0xe7,0x89,0x5d,0xb8,0xce,0x8b,0xf0,
+0x93,0x7b,0xd1,0xcf,0x12,0x3b,0x5d,0x75,0x7d,0x6c,0xc7,0x29,
+0x1b,0xb5,0x67,0xd2,0x8c,0x1b,0x34,0xae,0x38,0x74,0xbc,0xeb,
+0x68,0xfc,0x65,0x6f,0x1b,0x51,0xa0,0xed,0xfb,0x98,0x50,0xd3,
+0x47,0xe4,0xbe,0xa6,0xf8,0x4d,0xea,0x14,0x6c,0x70,0xe5,0x34,
+0x48,0xe0,0x24,0x5c,0x8e,0xb4,0x6f,0xc5,0x3e,0x06,0x5b,0xf7,
+0xd1,0x8c,0xbb,0x6a,0x42,0xb7,0x6b,0xf0,0xf3,0x9f,0x71,0x7b,
+0x2d,0x55,0xdd,0x20,0x05,0xe8,0x15,0x3e,0xa2,0xb1,0x78,0x49,
+0x54,0x5d,0xd0,0x85,0x43,0xf9,0x67,0x5d,0xca,0x6a,0xd7,0xb5,
+0x75,0xb0,0x0c,0x9d,0x09,0xda,0x76,0xdc,0x9c,0xf8,0xdd,0x34,
+0xa8,0x19,0xfc,0x3a,0x74,0x33,0xc1,0x73,0x4f,0x4f,0x40,0x07,
+0xf3,0x6b,0xee,0x61,0xde,0xeb,0x12,0xe1,0x3b,0xee,0x4c,0x9f,
+0x9e,0xfa,0xe4,0xfd,0x5e,0x2b,0x27,0xa4,0xb2,0x2c,0x22,0xf7,
+0x8d,0x7e,0xfe,0x27,0x74,0x49,0xfc,0xde,0xb7,0x5d,0x6f,0x6f,
+0x76,0x2d,0xc6,0x69,0x06,0xf1,0x3f,0xad,0x56,0x71,0x3a,0xe9,
+0x2a,0x94,0x6c,0xbc,0xfb,0x67,0xf7,0xba,0xdc,0xf5,0x41,0xe5,
+0x87,0xa1,0xfc,0x0a,0x45,0x79,0xd1,0x34,0x9e,0xf5,0xf7,0xba,
+0xdc,0xf9,0x72,0x1b,0x5e,0x46,0xe1,0xf4,0x32,0x89,0x76,0x02,
+0xd0,0x98,0x20,0xc0,0x3b,0x6a,0x28,0x95,0xc9,0x7d,0x60,0x28,
+0x2b,0xf8,0xa2,0xee,0x3f,0xa8,0x42,0x97,0x52,0xd5,0x71,0x4a,
+0xcc,0xe3,0xd0,0x12,0x05,0x56,0xb4,0x10,0x98,0xcb,0x8a,0x7d,
+0x9d,0x75,0xbd,0x26,0xd9,0x9c,0x81,0x7e,0x20,0x71,0x3d,0xac,
+0xde,0x8e,0xbe,0x33,0x7c,0x06,0x6d,0xd7,0x1e,0xa6,0xef,0xe8,
+0x1c,0xcb,0x21,0x0d,0x61,0xc9,0x9c,0xbd,0x49,0x83,0x96,0x1f,
+0xb0,0x79,0x4d,0xcb,0xe7,0x64,0xd7,0x2f,0x1c,0x31,0x51,0x31,
+0xd4,0xbe,0x01,0x53,0xe8,0xb1,0xff,0x59,0xb7,0x8c,0xaf,0x49,
+0x78,0x58,0xa5,0x00,0xbc,0x62,0x9e,0x1e,0xb7,0xde,0x13,0x81,
+0xf9,0x27,0x54,0xb5,0x5b,0xd1,0x71,0xe5,0x7e,0xff,0x6d,0x42,
+0x35,0x7a,0x4a,0xf7,0x9a,0x42,0xfe,0x50,0x3c,0x2e,0x4a,0xa3,
+0x93,0x43,0x6f,0xe5,0xc3,0x64,0xc8,0x10,0xcf,0x6e,0xcc,0x8f,
+0x14,0xe1,0x5f,0x3d,0xd7,0xf8,0x40,0xec,0x06,0x30,0x9e,0x71,
+0xb8,0x53,0x73,0xc5,0x2a,0x31,0x21,0x2e,0xfc,0xee,0xd8,0xf5,
+0xdb,0x60,0x48,0x92,0xb2,0x29,0xe3,0x89,0x37,0x0b,0xe4,0xe9,
+0x10,0x13,0x94,0x3a,0xba,0x30,0x08,0x69,0xe4,0xd6,0x13,0xdd,
+0xb1,0x97,0x8f,0x8e,0xe0,0xcd,0xa3,0x68,0x64,0x29,0x1c,0x26,
+0x9e,0x78,0x9b,0x81,0xf1,0xea,0x4c,0x6e,0x4a,0xe0,0xe9,0xef,
+0x38,0xd7,0x9f,0x8f,0x37,0x37,0x97,0x09,0x51,0x53,0xb9,0xea,
+0x2a,0x4e,0xc7,0xcc,0xb7,0xd2,0xfd,0x80,0x79,0x6c,0xfb,0xf1,
+0x8f,0x9b,0x14,0xdb,0x8d,0x22,0x4e,0xc3,0xbd,0x28,0x47,0x69,
+0x2f,0x87,0x9b,0x15,0x06,0x4e,0x1b,0xb4,0x20,0xc3,0x80,0x69,
+0x41,0x18,0x84,0xd2,0x70,0xad,0xe7,0xb1,0x9c,0x12,0x2f,0x31,
+0x1d,0xe7,0xd8,0x63,0x22,0xab,0x37,0x6b,0x54,0xce,0xf6,0x1d,
+0xf5,0xd9,0x19,0xf1,0x04,0x0e,0x66,0x69,0xe1,0x01,0x5b,0x07,
+0x52,0xc1,0x1a,0xd7,0xda,0xec,0xc0,0xbe,0x48,0xe3,0xd6,0xc9,
+0xa6,0x81,0x1a,0x99,0x45,0x48,0x9e,0x13,0x90,0x82,0x2d,0x19,
+0xfc,0x6f,0xc6,0xd8,0xae,0x6a,0xfe,0x6a,0x06,0xea,0xd2,0x8f,
+0x79,0x96,0x7a,0x5e,0x66,0x9e,0x4e,0xee,0x0f,0x74,0xed,0xc9,
+0x41,0x68,0x4c,0x78,0x49,0xb3,0x5e,0x61,0x7f,0xef,0xbf,0x17,
+0x65,0x17,0x8d,0xd1,0x6c,0x5a,0xff,0x66,0x87,0x4e,0xd8,0xd7,
+0xdc,0xc9,0x21,0xd4,0x92,0x71,0x20,0x79,0xf5,0xfe,0x1b,0x86,
+0x26,0xff,0x51,0x9b,0x75,0x06,0x26,0xbf,0xd4,0xd6,0x75,0xcf,
+0x9f,0x37,0x8d,0x93,0xad,0x30,0xbc,0x00,0xcc,0x3c,0xeb,0xff,
+0xf5,0xd7,0x54,0x3f,0x84,0xa8,0xe4,0x4b,0xd5,0xcf,0xd6,0x43,
+0xb8,0x50,0x3d,0xa6,0xb7,0x4a,0x1a,0xeb,0xc2,0x57,0x9b,0xe1,
+0xab,0x53,0xf8,0xae,0xe3,0xe7,0x79,0x37,0x89,0xca,0x70,0x8e,
+0x4f,0x1c,0x5b,0xef,0xd9,0x51,0x0c,0xfb,0x98,0x09,0x57,0xf9,
+0x95,0x8b,0x9f,0x74,0x7d,0xd4,0x2b,0xd1,0x30,0x8c,0xda,0xc6,
+0x0d,0x93,0x76,0xf8,0x1c,0x14,0x0a,0xa5,0x71,0x79,0xf8,0xa4,
+0xe4,0x33,0xc3,0xd1,0xd1,0x74,0xc9,0xe4,0x9f,0xfd,0x73,0xd7,
+0xfa,0x38,0xa9,0xba,0x3e,0xc7,0x97,0x0a,0xfa,0x82,0xe1,0x7d,
+0xc5,0x5a,0x5e,0x0a,0xb7,0x4a,0x8c,0xcb,0xfd,0x86,0xa1,0xbe,
+0x20,0xa7,0xb5,0x51,0x2d,0x08,0x98,0x1a,0x97,0x8f,0x53,0xd6,
+0xf3,0xde,0x29,0x8e,0xbd,0x0b,0x46,0xb8,0x5f,0xfa,0xb0,0x0f,
+0xdf,0xc8,0xf8,0x14,0x88,0x9a,0x4a,0xb6,0x03,0xe1,0x0d,0x3b,
+0xb6,0x34,0x9c,0x6b,0x8b,0x60,0xb1,0xf6,0x97,0x77,0x2d,0x76,
+0x70,0x8f,0xb0,0xe0,0x11,0x8e,0x78,0xed,0xe9,0xe9,0x00,0xda,
+0x64,0xd0,0x08,0xba,0x4e,0x27,0xb4,0xd0,0x7f,0x0b,0x34,0xe4,
+0xb1,0xe0,0x16,0x12,0x31,0xf6,0x01,0x5b,0xd7,0x0a,0xe7,0xd0,
+0xa8,0xbe,0x85,0x6d,0x87,0xcf,0x20,0x2f,0xbc,0x10,0xbe,0x84,
+0x90,0xe1,0x5b,0xa1,0xdc,0x78,0xbc,0xbd,0x6e,0x1b,0xe9,0x92,
+0xf1,0xac,0xd9,0x71,0xce,0x20,0x3e,0xe3,0x36,0xe3,0x2c,0xe6,
+0x29,0xe6,0x37,0x8f,0x4d,0x0f,0x84,0xc8,0x5a,0x2c,0x5f,0x06,
+0xc5,0xfe,0x10,0x06,0xf4,0x8c,0xf0,0x19,0x82,0x82,0x75,0xdb,
+0xbf,0x32,0x5e,0xbf,0x1c,0x6c,0x2d,0xd2,0x60,0x7b,0x12,0x26,
+0x66,0x2f,0xeb,0xd6,0x62,0x4d,0x9f,0x6e,0xee,0x46,0xc3,0xf3,
+0x9f,0xb5,0x54,0x49,0xad,0xdd,0xad,0x5c,0xdc,0x75,0x9a,0xf5,
+0xbe,0xf7,0xc7,0xb2,0xae,0x69,0xee,0x86,0xe9,0x25,0x4f,0xc4,
+0xb5,0x76,0xe9,0x62,0x44,0xcc,0xb7,0x31,0xa4,0x92,0x5f,0xd7,
+0x6a,0xf1,0x45,0xad,0x58,0x91,0xbb,0xdf,0x3a,0xbf,0x7f,0xf1,
+0xad,0xe0,0x7a,0x53,0x0d,0xdb,0x87,0xd7,0xd8,0x93,0xc6,0x16,
+0xeb,0xf4,0xed,0xf9,0x6b,0x82,0xb0,0x86,0x37,0xdd,0xe2,0x17,
+0x6d,0x9a,0xc4,0x7d,0x07,0x0d,0xf1,0x25,0xb7,0xa4,0xd4,0xc2,
+0x76,0xb0,0x44,0x87,0xe8,0xdf,0x03,0x00,0xdf,0xcf,0xc9,0x69,
+0x8a,0x17,0x00,0x00,
+	}))
+
+	if err != nil {
+		panic("Decompression failed: " + err.Error())
+	}
+
+	var b bytes.Buffer
+	io.Copy(&b, gz)
+	gz.Close()
+
+	return b.Bytes()
+}
\ No newline at end of file
@@ -0,0 +1,40 @@
+// Code generated by eg templates generate. DO NOT EDIT.
+
+package templates
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+)
+
+// Helper returns the raw, uncompressed contents of helper.go.mustache.
+func Helper() []byte {
+	gz, err := gzip.NewReader(bytes.NewBuffer([]byte{
+0x1f,0x8b,0x08,0x00,0x00,0x00,0x00,0x00,0x02,0xff,0x3c,0xce,
+0x31,0x4e,0x03,0x31,0x10,0x46,0xe1,0x7a,0xe7,0x14,0xbf,0x52,
+0x65,0x9b,0x75,0x4f,0x8d,0x90,0xa8,0x52,0xc0,0x05,0x26,0x66,
+0x9c,0x58,0xd8,0x13,0x6b,0x76,0x1c,0x14,0x59,0xbe,0x3b,0x05,
+0x28,0x07,0x78,0x4f,0x5f,0xe3,0xf8,0xcd,0x17,0xc1,0x55,0x4a,
+0x13,0xdb,0x89,0x72,0x6d,0x37,0x73,0x1c,0x69,0x39,0xa4,0xea,
+0x07,0x5a,0x89,0x42,0xc0,0x18,0x6f,0x5d,0xe3,0x9c,0x88,0xac,
+0x38,0x0b,0x22,0x97,0x22,0x5f,0x48,0x76,0xab,0x60,0x7d,0xe0,
+0x9e,0xe5,0x07,0xe7,0x07,0x94,0xab,0x6c,0x94,0xba,0xc6,0x67,
+0x72,0xbc,0x73,0xe9,0x82,0xac,0x2e,0x96,0x38,0xca,0x98,0x2b,
+0x76,0xb7,0xac,0x17,0x0c,0x5a,0x42,0xc0,0xe7,0xe9,0xf5,0xf4,
+0x82,0xf7,0xda,0x8a,0x54,0x51,0x87,0x5f,0xf3,0xfe,0xef,0xd9,
+0x68,0x31,0xf1,0x6e,0x8a,0x54,0x7d,0xfb,0x68,0x96,0xd5,0xff,
+0x7e,0x2b,0x4d,0xfa,0x1d,0x00,0x7e,0xbb,0xa9,0xe6,0xbc,0x00,
+0x00,0x00,
+	}))
+
+	if err != nil {
+		panic("Decompression failed: " + err.Error())
+	}
+
+	var b bytes.Buffer
+	io.Copy(&b, gz)
+	gz.Close()
+
+	return b.Bytes()
+}
\ No newline at end of file
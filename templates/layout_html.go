@@ -0,0 +1,36 @@
+// Code generated by eg templates generate. DO NOT EDIT.
+
+package templates
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+)
+
+// LayoutHTML returns the raw, uncompressed contents of layout.html.mustache.
+func LayoutHTML() []byte {
+	gz, err := gzip.NewReader(bytes.NewBuffer([]byte{
+0x1f,0x8b,0x08,0x00,0x00,0x00,0x00,0x00,0x02,0xff,0x2c,0xcd,
+0x41,0x0a,0xc3,0x20,0x14,0x84,0xe1,0xb5,0x9e,0xe2,0x35,0xe0,
+0xb6,0x5e,0x60,0xf2,0x8e,0xd0,0x3b,0xd8,0xf8,0x20,0x05,0x8d,
+0x59,0xcc,0x26,0x88,0x77,0x2f,0xc1,0xac,0x06,0x86,0x0f,0xfe,
+0xde,0x57,0x04,0x09,0xba,0x8e,0xe1,0xf1,0xca,0x6d,0xe3,0x75,
+0x9a,0xec,0xac,0x45,0x3d,0xe6,0x38,0xec,0x96,0xb2,0x7a,0xe7,
+0xc0,0x1f,0x8b,0x29,0x82,0x7c,0x52,0x35,0x09,0x8a,0x38,0x1f,
+0xef,0x10,0x1f,0x84,0x6f,0xcb,0xd7,0x8d,0x7b,0xa7,0xd5,0xb3,
+0x24,0x9a,0x2c,0x5b,0x3b,0x68,0x07,0x17,0x79,0x8f,0x71,0xdb,
+0x69,0x10,0x67,0xe0,0x3f,0x00,0x8b,0x17,0x00,0xfa,0x84,0x00,
+0x00,0x00,
+	}))
+
+	if err != nil {
+		panic("Decompression failed: " + err.Error())
+	}
+
+	var b bytes.Buffer
+	io.Copy(&b, gz)
+	gz.Close()
+
+	return b.Bytes()
+}
\ No newline at end of file
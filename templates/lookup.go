@@ -0,0 +1,101 @@
+package templates
+
+//go:generate go run github.com/murz/eg templates generate -src mustache -out .
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// LocalDir is the directory, relative to the app root, that is searched first
+// for template overrides.
+const LocalDir = ".eg/templates"
+
+// UserDir returns the per-user template override directory,
+// ~/.config/eg/templates, or "" if the home directory can't be determined.
+func UserDir() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".config", "eg", "templates")
+}
+
+// Dirs returns the override directories in the order they are searched.
+func Dirs() []string {
+	dirs := []string{LocalDir}
+	if user := UserDir(); user != "" {
+		dirs = append(dirs, user)
+	}
+	return dirs
+}
+
+// Find returns the data for the named template along with the file it was
+// read from. The file is "" when the embedded default was used.
+func Find(name string) ([]byte, string, error) {
+	for _, dir := range Dirs() {
+		filename := filepath.Join(dir, name)
+		data, err := ioutil.ReadFile(filename)
+		if err == nil {
+			return data, filename, nil
+		}
+		if !os.IsNotExist(err) {
+			return nil, filename, err
+		}
+	}
+	data, ok := Default(name)
+	if !ok {
+		return nil, "", fmt.Errorf("templates: unknown template %q", name)
+	}
+	return data, "", nil
+}
+
+// Get returns the data for the named template, checking the override
+// directories before falling back to the embedded default. It panics if the
+// template can't be found or read.
+func Get(name string) []byte {
+	data, _, err := Find(name)
+	if err != nil {
+		panic(err.Error())
+	}
+	return data
+}
+
+// Default returns the embedded default for the named template.
+func Default(name string) ([]byte, bool) {
+	fn, ok := defaults[name]
+	if !ok {
+		return nil, false
+	}
+	return fn(), true
+}
+
+// Names returns the names of all embedded templates, sorted.
+func Names() []string {
+	names := make([]string, 0, len(defaults))
+	for name := range defaults {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Eject copies the embedded default for the named template into dir so it can
+// be customized. It refuses to overwrite an existing file.
+func Eject(name string, dir string) (string, error) {
+	data, ok := Default(name)
+	if !ok {
+		return "", fmt.Errorf("templates: unknown template %q", name)
+	}
+	filename := filepath.Join(dir, name)
+	if _, err := os.Stat(filename); err == nil {
+		return filename, fmt.Errorf("templates: %s already exists", filename)
+	}
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return filename, err
+	}
+	return filename, ioutil.WriteFile(filename, data, 0666)
+}
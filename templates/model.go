@@ -0,0 +1,45 @@
+// Code generated by eg templates generate. DO NOT EDIT.
+
+package templates
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+)
+
+// Model returns the raw, uncompressed contents of model.go.mustache.
+func Model() []byte {
+	gz, err := gzip.NewReader(bytes.NewBuffer([]byte{
+0x1f,0x8b,0x08,0x00,0x00,0x00,0x00,0x00,0x02,0xff,0x5c,0x8f,
+0xc1,0x6e,0x83,0x30,0x0c,0x86,0xcf,0xf8,0x29,0x2c,0x7a,0x69,
+0xa5,0x09,0x5e,0xa2,0xaa,0xb6,0xcb,0x4e,0xbc,0x40,0x08,0x6e,
+0xb1,0x06,0x09,0x4a,0x8c,0xaa,0xca,0xca,0xbb,0x4f,0x81,0x96,
+0xb2,0x9d,0x12,0xfb,0xb7,0xec,0xef,0x9b,0x8c,0xfd,0x31,0x37,
+0xc2,0xd1,0x77,0x34,0x44,0x50,0x3d,0x7c,0x9a,0xd8,0xf0,0x48,
+0x29,0x01,0xf0,0x38,0xf9,0x20,0x58,0x0a,0x8f,0x54,0x82,0x6a,
+0xbd,0xcb,0xea,0x1a,0x55,0xbf,0x4d,0x2e,0x90,0x23,0x1a,0x0c,
+0xfe,0x8e,0xfe,0x8a,0xd2,0x13,0xaa,0x36,0xa6,0x1d,0x72,0x22,
+0xf9,0x5d,0x97,0x9e,0x8d,0x98,0xd6,0xc4,0x65,0xde,0x3d,0xc7,
+0x76,0xbd,0xee,0xf9,0x5d,0xaf,0xbc,0x83,0x0a,0xe4,0x31,0xd1,
+0xfb,0x56,0x94,0x30,0x5b,0x41,0x85,0xe2,0xeb,0x8c,0xec,0x04,
+0x0a,0xd5,0xc3,0x85,0x69,0xe8,0x62,0x4a,0x50,0x6c,0x73,0xaa,
+0xcd,0x63,0xa2,0xb5,0x55,0x6f,0x79,0x82,0xff,0x30,0x8b,0xc9,
+0xab,0xcc,0x26,0x99,0xcc,0x7a,0xe7,0xc8,0x0a,0x7b,0xb7,0x73,
+0x31,0x81,0x30,0x8a,0x0f,0xd4,0x21,0xbb,0x0f,0xbc,0xf7,0x6c,
+0x7b,0xec,0xda,0xea,0xe2,0x03,0xce,0x91,0x62,0x05,0xd7,0xd9,
+0x59,0x3c,0xbe,0x10,0x4e,0xdb,0xda,0xe3,0x29,0x63,0xb3,0xbb,
+0x65,0xec,0x40,0x32,0x07,0x87,0xe5,0xde,0xbe,0x5c,0xc0,0xfe,
+0x8a,0xc3,0xef,0x00,0xb6,0xe5,0x60,0x66,0x9b,0x01,0x00,0x00,
+	}))
+
+	if err != nil {
+		panic("Decompression failed: " + err.Error())
+	}
+
+	var b bytes.Buffer
+	io.Copy(&b, gz)
+	gz.Close()
+
+	return b.Bytes()
+}
\ No newline at end of file
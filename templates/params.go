@@ -0,0 +1,59 @@
+// Code generated by eg templates generate. DO NOT EDIT.
+
+package templates
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+)
+
+// Params returns the raw, uncompressed contents of params.go.mustache.
+func Params() []byte {
+	gz, err := gzip.NewReader(bytes.NewBuffer([]byte{
+0x1f,0x8b,0x08,0x00,0x00,0x00,0x00,0x00,0x02,0xff,0x74,0x92,
+0x31,0x8f,0xd4,0x30,0x10,0x85,0xeb,0xf5,0xaf,0x18,0x85,0x82,
+0x5d,0x74,0x8a,0xaf,0xa0,0x42,0xa2,0x38,0x90,0x10,0x05,0xd7,
+0x21,0x9a,0xd3,0x15,0xde,0x78,0x92,0x58,0x38,0xe3,0xe0,0x99,
+0x70,0x5a,0x2c,0xff,0x77,0xe4,0x38,0xec,0x05,0xc4,0x95,0x9e,
+0x79,0xef,0x9b,0xa7,0x97,0xcc,0xa6,0xfb,0x6e,0x06,0x84,0xc9,
+0x38,0x52,0xca,0x4d,0x73,0x88,0x02,0x47,0x75,0x68,0x22,0xf6,
+0x1e,0x3b,0x69,0x94,0x3a,0x34,0x83,0x93,0x71,0x39,0xb7,0x5d,
+0x98,0xf4,0xb4,0xc4,0x5f,0x1a,0x07,0x3d,0x9b,0x68,0x26,0x6e,
+0xd4,0x21,0xa5,0x57,0x1f,0x1c,0x59,0xfe,0x18,0x48,0x62,0xf0,
+0x1e,0x23,0xe7,0xac,0x0e,0x4d,0x4a,0x70,0x1f,0xec,0xe2,0x11,
+0x72,0xd6,0x66,0x9e,0x75,0xf7,0x2c,0x58,0x6d,0xfa,0x7f,0xb6,
+0x3f,0xb4,0xfb,0x60,0xd1,0xbf,0x00,0x9a,0xd6,0xdd,0x8e,0x71,
+0x15,0x9f,0x94,0xd2,0x1a,0xbe,0x8e,0x08,0x67,0x47,0x16,0x23,
+0xc3,0x6c,0x22,0x23,0xc8,0x88,0xb0,0x06,0x46,0x29,0xc3,0xd0,
+0xaf,0x13,0xd3,0x89,0x0b,0xc4,0xe0,0x48,0x42,0x19,0xb8,0x08,
+0x72,0x99,0x91,0x6f,0xc0,0x90,0x2d,0x20,0x43,0xfc,0x84,0x11,
+0x9e,0x9c,0x8c,0x60,0xe0,0xed,0xed,0x2d,0x78,0xc7,0xe2,0x68,
+0x58,0xed,0x81,0x90,0x41,0x46,0x23,0x60,0x03,0xbd,0x96,0x7a,
+0xea,0x06,0xce,0xd8,0x87,0x58,0x4f,0x46,0xfc,0xb1,0x20,0x4b,
+0x41,0x0d,0x28,0x0c,0x12,0x76,0x77,0xdb,0x92,0xf3,0x02,0x81,
+0xfc,0x05,0x7e,0x1a,0xef,0xac,0x11,0x7c,0x07,0x38,0x84,0x35,
+0x3b,0x57,0x65,0x1c,0x96,0x09,0x49,0x78,0xe7,0x2b,0x34,0xc7,
+0xd0,0x19,0xef,0xd1,0xd6,0x6c,0x4e,0x18,0x7d,0xdf,0xaa,0x7e,
+0xa1,0x0e,0x1c,0x39,0x39,0x9e,0x20,0x5d,0xbb,0xdc,0x9a,0xdd,
+0x1a,0x79,0x28,0x7d,0xde,0xad,0x24,0xc8,0xb9,0x79,0x84,0xf7,
+0xb5,0x19,0x6e,0x3f,0x1b,0xb2,0x1e,0xe3,0x31,0x25,0xf8,0x54,
+0x40,0x39,0x9f,0xae,0x15,0x57,0x46,0x56,0x7f,0x21,0xeb,0xbd,
+0x67,0xf9,0xf1,0x0c,0x6f,0x36,0x56,0x15,0x9d,0xe0,0xe1,0x71,
+0xfb,0x8d,0xda,0x6f,0xc6,0x2f,0xb8,0xa5,0xfa,0xe2,0x08,0xb7,
+0xaf,0x9d,0xa0,0x3c,0x20,0xd7,0x97,0xbe,0x6e,0x22,0xca,0x12,
+0xe9,0x5f,0x7f,0x82,0xe2,0xb8,0x8b,0x03,0x17,0x07,0xd4,0x44,
+0xbb,0x80,0xbf,0x07,0x00,0x22,0x39,0x1a,0x35,0xcd,0x02,0x00,
+0x00,
+	}))
+
+	if err != nil {
+		panic("Decompression failed: " + err.Error())
+	}
+
+	var b bytes.Buffer
+	io.Copy(&b, gz)
+	gz.Close()
+
+	return b.Bytes()
+}
\ No newline at end of file
@@ -0,0 +1,75 @@
+// Code generated by eg templates generate. DO NOT EDIT.
+
+package templates
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+)
+
+// RequestHTML returns the raw, uncompressed contents of request.html.mustache.
+func RequestHTML() []byte {
+	gz, err := gzip.NewReader(bytes.NewBuffer([]byte{
+0x1f,0x8b,0x08,0x00,0x00,0x00,0x00,0x00,0x02,0xff,0x9c,0x54,
+0xc1,0x6e,0xdb,0x38,0x10,0xbd,0xeb,0x2b,0xb8,0x32,0xb0,0x27,
+0x7b,0x29,0x4b,0x59,0x63,0x21,0x31,0x02,0xb2,0x48,0x8b,0x14,
+0x6d,0x7a,0x48,0xd1,0x5e,0x0b,0x5a,0x1c,0x59,0x42,0x68,0x51,
+0x25,0xa9,0xc4,0xee,0x80,0xff,0x5e,0x30,0xa2,0x1c,0x2b,0x09,
+0x7a,0x28,0x7c,0xf0,0xe3,0x9b,0xc7,0xe1,0xbc,0xe1,0x50,0xec,
+0x2f,0xa1,0x2a,0x7b,0xec,0x81,0x34,0x76,0x2f,0xcb,0x88,0x4d,
+0x7f,0xc0,0x45,0x19,0x31,0xdb,0x5a,0x09,0x25,0xe2,0x2d,0xd8,
+0x46,0x09,0xe7,0x08,0xe2,0xd7,0xbb,0x4f,0xce,0x91,0x15,0x81,
+0x9d,0x62,0x74,0x8c,0x47,0xcc,0xd8,0xa3,0x04,0xe2,0xf3,0x5c,
+0xc6,0x16,0x0e,0x96,0x56,0xc6,0xc4,0x65,0xb4,0x55,0xe2,0x48,
+0x70,0xcf,0xf5,0xae,0xed,0xf2,0xa4,0xe8,0xb9,0x10,0x6d,0xb7,
+0xcb,0x93,0xa2,0x56,0x9d,0x5d,0xd5,0x7c,0xdf,0xca,0x63,0x1e,
+0xdf,0x80,0x7c,0x00,0xdb,0x56,0x9c,0x7c,0x86,0x01,0xe2,0xe5,
+0x69,0xbd,0xbc,0xd2,0x2d,0x97,0x4b,0xc3,0x3b,0xb3,0x32,0xa0,
+0xdb,0xba,0x70,0x51,0xb3,0x5e,0x36,0xe9,0xb2,0xc9,0xde,0x48,
+0x9b,0x26,0xfd,0xe1,0x49,0x41,0x70,0xcb,0xab,0xfb,0x9d,0x56,
+0x43,0x27,0xf2,0x05,0x00,0x14,0x95,0x92,0x4a,0xe7,0x8b,0xcd,
+0x66,0xe3,0x05,0xe9,0x5c,0x90,0xf1,0x8b,0xec,0xdf,0x6c,0xd2,
+0xd4,0xf5,0xd3,0x31,0xe9,0x3f,0xa0,0xf5,0x5c,0x27,0xfe,0xcb,
+0x36,0x49,0xe2,0x83,0x19,0xc1,0xa0,0x4e,0xd3,0xb4,0x70,0x91,
+0xe5,0x5b,0x09,0x04,0xb7,0x4a,0x0b,0xd0,0xab,0x4a,0x49,0xc9,
+0x7b,0x03,0xf9,0x04,0x46,0xbb,0xa6,0xfd,0x09,0xf9,0xfa,0xa2,
+0x3f,0x14,0x53,0xe5,0x24,0x54,0x6c,0x05,0xc1,0x93,0x89,0xfe,
+0x40,0xd6,0x49,0x7f,0x20,0x1e,0x24,0xc5,0x03,0x68,0xdf,0x09,
+0xb9,0xe2,0xb2,0xdd,0x75,0xb9,0x55,0x7d,0xe1,0xa2,0x5e,0xc3,
+0xbc,0xb2,0x7a,0xe3,0x7f,0xa7,0x46,0xac,0x93,0x57,0x87,0x3c,
+0x36,0xad,0x85,0x95,0xe9,0x79,0x05,0x79,0xaf,0x61,0xf5,0xa8,
+0xb9,0xcf,0xc4,0x4f,0x46,0x42,0x13,0x5c,0xc4,0xe8,0xd3,0x65,
+0x96,0x11,0xa3,0x61,0x08,0xfc,0x2d,0xfa,0x91,0x58,0x97,0x8c,
+0x93,0x46,0x43,0x7d,0x19,0xd3,0xef,0xb0,0xa3,0x1a,0x7e,0x0c,
+0x60,0xac,0x89,0xcb,0xbb,0x80,0x18,0xe5,0x25,0xa1,0x04,0xf1,
+0xc3,0xb5,0x73,0x8c,0x36,0x6b,0xbf,0x2d,0x45,0x5c,0xbc,0xd3,
+0x5a,0x69,0xe7,0x48,0x25,0xb9,0x31,0x97,0x31,0x68,0x1d,0x23,
+0xd2,0xc0,0xbe,0x35,0x5f,0x7f,0xef,0x5b,0x21,0x94,0x2d,0x08,
+0xe2,0x17,0xcb,0xed,0x60,0xe6,0xdc,0xf5,0xa0,0xb9,0x6d,0x55,
+0xe7,0x1c,0xe2,0xe2,0xaa,0x1a,0xe1,0xb9,0x60,0xe2,0x10,0xe9,
+0x33,0x5c,0xdc,0xaa,0xea,0x7e,0xae,0x1b,0x19,0x44,0x3a,0x81,
+0xc5,0x7b,0x3e,0x48,0x7b,0x2e,0xaa,0x3d,0x91,0x13,0xc4,0x10,
+0x41,0xa4,0x01,0x31,0xda,0xa4,0xde,0x61,0x36,0x35,0x80,0xf8,
+0x8e,0x81,0x36,0x8c,0x36,0x99,0x7f,0x3d,0x7e,0x2c,0xca,0x08,
+0x71,0x11,0xe2,0x37,0x63,0xd8,0x39,0x66,0x75,0xc9,0xac,0x28,
+0x11,0x3f,0xc2,0xd1,0x27,0xb2,0x22,0xac,0xbf,0x71,0x39,0xc0,
+0xc4,0x50,0xab,0x4b,0x44,0xfa,0x72,0x77,0xc4,0xe8,0xab,0xd4,
+0xff,0x2b,0xe1,0x13,0x9d,0xd5,0xe2,0xaf,0x2d,0x14,0xd2,0x6b,
+0xff,0x88,0xe7,0x4a,0x3a,0x92,0x74,0xc6,0x06,0x33,0xa6,0x57,
+0x9d,0x81,0xdf,0xb8,0x19,0x05,0x7f,0x6c,0xe7,0xc5,0xf6,0x17,
+0x7e,0xc6,0xe8,0xb9,0xa1,0x50,0xcf,0x1b,0x8e,0x66,0xda,0x67,
+0x4b,0xe7,0x74,0xc4,0x68,0x98,0x60,0x3a,0x7e,0xdc,0x7e,0x0d,
+0x00,0x50,0x53,0x8b,0x08,0xf4,0x04,0x00,0x00,
+	}))
+
+	if err != nil {
+		panic("Decompression failed: " + err.Error())
+	}
+
+	var b bytes.Buffer
+	io.Copy(&b, gz)
+	gz.Close()
+
+	return b.Bytes()
+}
\ No newline at end of file
@@ -0,0 +1,74 @@
+// Code generated by eg templates generate. DO NOT EDIT.
+
+package templates
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+)
+
+// RequestsHTML returns the raw, uncompressed contents of requests.html.mustache.
+func RequestsHTML() []byte {
+	gz, err := gzip.NewReader(bytes.NewBuffer([]byte{
+0x1f,0x8b,0x08,0x00,0x00,0x00,0x00,0x00,0x02,0xff,0x8c,0x54,
+0x51,0x6f,0xd3,0x3c,0x14,0x7d,0xcf,0xaf,0xf0,0x97,0xe8,0x7b,
+0x6b,0xeb,0x64,0x2d,0x15,0x4a,0xbc,0x48,0x93,0x06,0x1a,0x12,
+0x43,0x68,0xc0,0xeb,0x90,0x1b,0xdf,0x34,0xd6,0xdc,0x38,0xd8,
+0x0e,0xb4,0x58,0xfe,0xef,0xc8,0x71,0x92,0x95,0x51,0x09,0x9e,
+0x7c,0xef,0x71,0xce,0xbd,0xe7,0xfa,0x1e,0x85,0xfc,0xc7,0x64,
+0x65,0x4e,0x1d,0xa0,0xc6,0x1c,0x44,0x19,0x91,0xe9,0x00,0xca,
+0xca,0x88,0x18,0x6e,0x04,0x94,0x0f,0xf0,0xad,0x07,0x6d,0x34,
+0x5a,0x22,0xd8,0x4b,0x82,0x03,0x1a,0x11,0x6d,0x4e,0x02,0x90,
+0x67,0x5f,0xc7,0x06,0x8e,0x06,0x57,0x5a,0xc7,0x65,0xb4,0x93,
+0xec,0x84,0xec,0x81,0xaa,0x3d,0x6f,0xf3,0xb4,0xe8,0x28,0x63,
+0xbc,0xdd,0xe7,0x69,0x51,0xcb,0xd6,0x2c,0x6b,0x7a,0xe0,0xe2,
+0x94,0xc7,0x77,0x20,0xbe,0x83,0xe1,0x15,0x45,0x1f,0xa0,0x87,
+0x78,0x31,0xe7,0x8b,0x1b,0xc5,0xa9,0x58,0x68,0xda,0xea,0xa5,
+0x06,0xc5,0xeb,0xc2,0x45,0x4d,0x76,0xa1,0xe0,0x55,0xda,0x1d,
+0x8b,0x1d,0xad,0x9e,0xf6,0x4a,0xf6,0x2d,0xcb,0x13,0x00,0x28,
+0x2a,0x29,0xa4,0xca,0x93,0xed,0x76,0x5b,0xb8,0xc8,0xd0,0x9d,
+0x00,0x64,0x77,0x52,0x31,0x50,0xcb,0x4a,0x0a,0x41,0x3b,0x0d,
+0xf9,0x14,0x14,0x3f,0x38,0x33,0x4d,0x9e,0xa5,0xe9,0xff,0x41,
+0x9b,0xe6,0x3f,0x21,0xcf,0x36,0xdd,0xd1,0x73,0x9b,0x85,0x61,
+0xc8,0xfa,0xb9,0x96,0x54,0xf0,0x7d,0x9b,0x0b,0xa8,0xcd,0xdc,
+0x7c,0xdb,0x1d,0x51,0x10,0x10,0x8a,0xef,0xa4,0x31,0xf2,0x90,
+0x67,0xdd,0x11,0x69,0x29,0x38,0x43,0x83,0x1a,0x17,0x51,0x64,
+0x47,0x49,0x6b,0xba,0x59,0xbf,0x5a,0x17,0x2e,0x5a,0x81,0x52,
+0x33,0xca,0x5e,0xaf,0xb7,0x69,0xea,0xd1,0x9a,0xf6,0xc2,0x20,
+0x7b,0x3e,0x50,0xbd,0x63,0xeb,0x6a,0x33,0xf7,0xf4,0xc5,0x37,
+0xcf,0x2d,0x15,0x65,0xbc,0xd7,0xf9,0xd5,0x20,0x77,0x75,0x90,
+0xd5,0xd3,0x0b,0x36,0xab,0xb3,0x7f,0x64,0x13,0x3c,0xec,0xb2,
+0x8c,0x08,0x1e,0x37,0xef,0x97,0xe8,0x7d,0x90,0xcd,0xdb,0x27,
+0xb8,0xc9,0xbc,0x25,0xfc,0x9b,0xfa,0x53,0x95,0xc4,0x34,0x65,
+0x42,0xb0,0x69,0x86,0xe8,0x33,0x3f,0xc0,0x9c,0xdc,0x83,0x69,
+0x24,0x9b,0xd3,0x8f,0xd4,0x34,0x73,0xf2,0xc9,0x50,0xd3,0xeb,
+0x39,0xbd,0xed,0x15,0x35,0x5c,0xb6,0x33,0x70,0x47,0x5b,0x26,
+0x40,0x85,0x1c,0x1b,0x55,0x46,0xd6,0x26,0x93,0x0a,0xe7,0x7c,
+0x6b,0x6b,0x93,0x37,0x4a,0x49,0xe5,0x1c,0xaa,0x04,0xd5,0xfa,
+0x3a,0x06,0xa5,0x62,0x6b,0xf1,0x88,0x96,0xc4,0xb0,0x92,0x50,
+0xd4,0x28,0xa8,0xaf,0x63,0xfc,0x15,0xf6,0x58,0x8d,0x05,0xb0,
+0xb5,0xef,0x6e,0x9d,0x8b,0xcb,0x70,0x12,0x4c,0x7d,0x13,0x36,
+0x30,0xac,0xf5,0x43,0x38,0x77,0x06,0x84,0x41,0xce,0xa0,0xbf,
+0x56,0xfd,0xf2,0xf0,0xfe,0x8f,0xb2,0xc9,0xad,0x6c,0xc1,0x39,
+0x6b,0x93,0x30,0xbc,0x0f,0x9f,0x23,0xfc,0x1c,0x3e,0x4e,0xe1,
+0xf2,0x37,0x18,0x4f,0xfc,0xc7,0x10,0xac,0x56,0xab,0x19,0xbc,
+0xd4,0x66,0x7a,0x53,0xe7,0x2e,0x7e,0xf6,0xd6,0x5b,0xcd,0x39,
+0xa2,0x3b,0xda,0x4e,0x0f,0x38,0xd8,0xcf,0xeb,0x9f,0x2e,0xb1,
+0xbf,0x2d,0x91,0xb5,0x78,0x44,0xac,0x4d,0xee,0x65,0xf5,0xf4,
+0x82,0xe7,0x7d,0xe7,0x69,0xe3,0x55,0x60,0x59,0x8b,0x43,0x6e,
+0xed,0x4d,0x15,0x84,0x84,0xfe,0xe3,0x3a,0xf1,0xf9,0x3a,0xf1,
+0x64,0x29,0x3c,0x9a,0x0e,0x87,0x9f,0xd0,0xaf,0x01,0x00,0x6a,
+0xa8,0xef,0x3e,0x9c,0x04,0x00,0x00,
+	}))
+
+	if err != nil {
+		panic("Decompression failed: " + err.Error())
+	}
+
+	var b bytes.Buffer
+	io.Copy(&b, gz)
+	gz.Close()
+
+	return b.Bytes()
+}
\ No newline at end of file
@@ -1,3 +1,5 @@
+// Code generated by eg templates generate. DO NOT EDIT.
+
 package templates
 
 import (
@@ -6,19 +8,19 @@ import (
 	"io"
 )
 
-// Routes returns raw, uncompressed file data.
+// Routes returns the raw, uncompressed contents of routes.go.mustache.
 func Routes() []byte {
 	gz, err := gzip.NewReader(bytes.NewBuffer([]byte{
-0x1f,0x8b,0x08,0x00,0x00,0x09,0x6e,0x88,0x00,0xff,0x1c,0xcb,
+0x1f,0x8b,0x08,0x00,0x00,0x00,0x00,0x00,0x02,0xff,0x1c,0xc9,
 0xb1,0x0d,0xc2,0x40,0x0c,0x05,0xd0,0xfe,0x4f,0xf1,0x95,0x0a,
 0x9a,0x78,0x06,0x56,0x60,0x83,0x70,0x71,0xee,0x22,0x74,0xf1,
-0xc9,0xd8,0x05,0x20,0x76,0x07,0x51,0x3f,0xbd,0xb1,0x94,0xfb,
-0x52,0x95,0xc5,0x8e,0x0d,0x10,0xe1,0xde,0x87,0x79,0x70,0xaa,
-0x7b,0xb4,0xbc,0xcd,0xc5,0xba,0xf4,0xf4,0x97,0x68,0x35,0x69,
-0x11,0x63,0x02,0xb6,0x3c,0x0a,0xaf,0x96,0xa1,0x8f,0xd3,0x99,
-0x6f,0x90,0xbf,0x77,0x59,0x57,0x3e,0x2d,0x9d,0xfe,0x07,0x36,
-0x75,0x9d,0xf1,0x01,0xbe,0x01,0x00,0x00,0xff,0xff,0x54,0x9e,
-0xd6,0x46,0x62,0x00,0x00,0x00,
+0xc9,0xd8,0x05,0x20,0x76,0x47,0x4a,0xfd,0xc6,0x52,0x9e,0x4b,
+0x55,0x16,0x3b,0x36,0x40,0x84,0x7b,0x1f,0xe6,0xc1,0xa9,0xee,
+0xd1,0xf2,0x31,0x17,0xeb,0xd2,0xd3,0x3f,0xa2,0xd5,0xa4,0x45,
+0x8c,0x09,0xd8,0xf2,0x28,0xbc,0x5b,0x86,0xbe,0x2e,0x57,0x7e,
+0x41,0x8a,0xf0,0xb6,0xae,0x7c,0x5b,0x3a,0xfd,0x04,0x36,0x75,
+0x9d,0xf1,0x03,0xfe,0x03,0x00,0x54,0x9e,0xd6,0x46,0x62,0x00,
+0x00,0x00,
 	}))
 
 	if err != nil {
@@ -0,0 +1,47 @@
+// Code generated by eg templates generate. DO NOT EDIT.
+
+package templates
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+)
+
+// Seeds returns the raw, uncompressed contents of seeds.go.mustache.
+func Seeds() []byte {
+	gz, err := gzip.NewReader(bytes.NewBuffer([]byte{
+0x1f,0x8b,0x08,0x00,0x00,0x00,0x00,0x00,0x02,0xff,0xa4,0x8f,
+0xcf,0x6a,0x03,0x21,0x10,0xc6,0xcf,0xce,0x53,0x4c,0x3d,0x29,
+0x04,0x43,0xaf,0x2d,0xb9,0xb4,0x9b,0x86,0x1e,0xda,0x1c,0xf6,
+0x01,0x16,0xb3,0x8e,0x45,0xea,0xea,0x46,0x4d,0x28,0x2c,0xbe,
+0x7b,0x71,0x5b,0x28,0x3d,0xe7,0x24,0xf8,0xfd,0xf9,0x7d,0x33,
+0xeb,0xf1,0x53,0x7f,0x10,0x4e,0xda,0x05,0x00,0x37,0xcd,0x31,
+0x15,0x14,0xc0,0xb8,0xd1,0x45,0x9f,0x74,0xa6,0x6d,0x3e,0x7b,
+0x0e,0x8c,0xdb,0xa9,0xb4,0x27,0x66,0x0e,0xc0,0x06,0xe4,0xcb,
+0x82,0xaf,0x3f,0xee,0x5a,0x9b,0xb0,0x2c,0xf8,0x16,0xcd,0xc5,
+0x13,0xd6,0xba,0x1d,0x63,0xb0,0x1c,0x58,0x26,0x32,0x19,0xff,
+0x4b,0xe6,0xc4,0x41,0x02,0xd8,0x4b,0x18,0x57,0xa8,0x90,0xb8,
+0x00,0x6b,0x01,0xd5,0xfd,0x22,0xb3,0x90,0xeb,0x4f,0xd8,0x20,
+0xa5,0x84,0x0f,0x3b,0xcc,0x67,0xaf,0x8e,0x33,0x05,0xd1,0xba,
+0xba,0xe4,0xae,0x94,0x1a,0x76,0x83,0x31,0xab,0x03,0x15,0x0a,
+0x57,0xc1,0xf7,0x87,0xe3,0xd0,0x3d,0x0d,0x5d,0xff,0xce,0xa5,
+0x04,0xe6,0xec,0x1a,0xbe,0xdb,0x61,0x70,0xbe,0x21,0x98,0x9d,
+0x8a,0x7a,0x99,0x93,0x0b,0xc5,0x07,0x11,0xb3,0xea,0x8b,0xa1,
+0x94,0x56,0x86,0x04,0xc6,0x62,0x56,0xfb,0x2f,0x57,0xc4,0xbd,
+0x04,0x56,0x81,0x19,0xb2,0x94,0xb0,0xad,0x50,0xcf,0x3e,0x66,
+0x12,0x7f,0x9d,0x6d,0x50,0xbb,0x4c,0xf5,0x44,0x46,0x34,0x8b,
+0x7c,0xbc,0x09,0x56,0xe1,0x7b,0x00,0x74,0x43,0xa3,0x57,0x87,
+0x01,0x00,0x00,
+	}))
+
+	if err != nil {
+		panic("Decompression failed: " + err.Error())
+	}
+
+	var b bytes.Buffer
+	io.Copy(&b, gz)
+	gz.Close()
+
+	return b.Bytes()
+}
\ No newline at end of file
@@ -0,0 +1,74 @@
+// Code generated by eg templates generate. DO NOT EDIT.
+
+package templates
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+)
+
+// TestsHTML returns the raw, uncompressed contents of tests.html.mustache.
+func TestsHTML() []byte {
+	gz, err := gzip.NewReader(bytes.NewBuffer([]byte{
+0x1f,0x8b,0x08,0x00,0x00,0x00,0x00,0x00,0x02,0xff,0x9c,0x54,
+0x4d,0x6f,0xdb,0x38,0x10,0xbd,0xf3,0x57,0x70,0xe5,0x4b,0x16,
+0xb0,0x4d,0x5b,0x0a,0x0c,0x43,0xa6,0x05,0xe4,0xb0,0x8b,0x3d,
+0x65,0x83,0xb6,0xe7,0x00,0x8c,0x34,0xb2,0x88,0xd0,0x14,0x2b,
+0x52,0x81,0xdd,0x01,0xff,0x7b,0x21,0xea,0x23,0x56,0x90,0x02,
+0x45,0x4f,0xd6,0x70,0x1e,0xdf,0x3c,0x3e,0x3e,0x9a,0xff,0x55,
+0xd4,0xb9,0xbb,0x1a,0xa0,0x95,0x3b,0xab,0x8c,0xf0,0xf1,0x07,
+0x44,0x91,0x11,0xee,0xa4,0x53,0x90,0x7d,0x03,0xeb,0x2c,0x5d,
+0x51,0x38,0xd5,0x9c,0xf5,0x4b,0x04,0x71,0xf1,0xa5,0xd5,0x5a,
+0xea,0x93,0xf7,0xfc,0x0c,0x4e,0xd0,0xca,0x39,0xb3,0x82,0xef,
+0xad,0x7c,0x3b,0x46,0x0d,0x94,0x0d,0xd8,0x2a,0xa2,0x79,0xad,
+0x1d,0x68,0x77,0x8c,0xe2,0x28,0x43,0x64,0xd3,0x16,0xc2,0xad,
+0xbb,0x2a,0xa0,0xdd,0xe8,0x63,0xe4,0xe0,0xe2,0x58,0x6e,0x6d,
+0x94,0x91,0x97,0xba,0xb8,0x52,0x3c,0x8b,0xe6,0x24,0x75,0xba,
+0x39,0x18,0x51,0x14,0x52,0x9f,0xd2,0xcd,0xa1,0xac,0xb5,0x5b,
+0x95,0xe2,0x2c,0xd5,0x35,0x8d,0xfe,0x03,0xf5,0x06,0x4e,0xe6,
+0x82,0x3e,0x42,0x0b,0xd1,0x72,0xaa,0x97,0x0f,0x8d,0x14,0x6a,
+0x69,0x85,0xb6,0x2b,0x0b,0x8d,0x2c,0x0f,0x9e,0x54,0xdb,0x65,
+0x15,0x2f,0xab,0xe4,0x13,0xda,0x78,0x63,0x2e,0x01,0x41,0xf1,
+0x45,0xe4,0xaf,0xa7,0xa6,0x6e,0x75,0x91,0x2e,0x00,0xe0,0x90,
+0xd7,0xaa,0x6e,0xd2,0xc5,0x6e,0xb7,0xeb,0x00,0xf1,0xda,0x08,
+0x6b,0xe7,0xa8,0x44,0xec,0x45,0x22,0x46,0x60,0x59,0x96,0x01,
+0x57,0x0a,0xa9,0xe6,0xb8,0x62,0x9f,0xec,0x36,0x9b,0x8f,0xb8,
+0xa6,0xb7,0x62,0x0e,0xdd,0xef,0xf7,0x73,0x5c,0x42,0x71,0xa8,
+0xe3,0x38,0x3e,0x78,0xd2,0x2a,0x8a,0x4a,0x5a,0xb7,0x0a,0xf6,
+0xa5,0xba,0xd6,0xd0,0x3b,0x63,0xe5,0x0f,0x48,0xb7,0x3b,0x73,
+0x79,0xb7,0x8c,0x86,0xd3,0x4d,0x67,0xf6,0x44,0x49,0x8a,0x63,
+0xf7,0xde,0x5c,0x68,0xb7,0x36,0xe8,0x1d,0x86,0x0c,0x52,0x3d,
+0x59,0xdb,0x57,0x69,0xa6,0xe5,0x4e,0x96,0x27,0xa6,0x81,0xb9,
+0xda,0xb2,0x2c,0x92,0xfc,0x7e,0x1a,0xb8,0xbd,0x19,0x17,0xe8,
+0xe9,0xb6,0xb7,0x97,0xb3,0xa0,0x36,0x23,0x9c,0x0d,0xb9,0xea,
+0x6e,0xb9,0x4b,0xd9,0xb6,0xcf,0x16,0x67,0xd5,0xf6,0x43,0xa4,
+0xaa,0x98,0xe6,0x4a,0x58,0x7b,0x8c,0x06,0xa3,0xa2,0x6c,0x68,
+0x52,0x2b,0x75,0x0e,0x14,0xf1,0xab,0x13,0x8d,0x83,0xc2,0xfb,
+0xf5,0x7a,0xcd,0x59,0x15,0xcf,0x03,0x86,0xf8,0x3c,0x15,0x88,
+0x8b,0x27,0x61,0x2d,0x14,0x33,0xe2,0xee,0x46,0xa3,0xec,0x41,
+0x29,0xea,0x42,0xc0,0x4d,0x80,0xd0,0x3b,0xdb,0xf3,0xde,0x4e,
+0xf8,0x7b,0xe4,0x1f,0x69,0x10,0x9f,0x3f,0x61,0xec,0xbc,0x8c,
+0x86,0xe7,0xd2,0x7d,0xff,0x3e,0xdb,0x4c,0xf8,0xe2,0x49,0xe4,
+0xaf,0xe2,0x04,0xb6,0x7b,0x27,0x55,0x32,0xb2,0x23,0x3e,0xe4,
+0x4e,0xd6,0xda,0xfb,0x28,0x7b,0xff,0xa6,0x88,0x8f,0xe2,0x0c,
+0xde,0xd3,0x3b,0xc4,0x7f,0x94,0x30,0x81,0xd1,0x76,0x33,0x92,
+0xe0,0xe9,0xbf,0x41,0x48,0x90,0x1c,0x94,0x79,0xcf,0x4d,0x03,
+0x19,0xe2,0xff,0xad,0x33,0xad,0xf3,0x9e,0xb3,0xbe,0x66,0x43,
+0x1b,0x91,0x8d,0x7b,0x08,0x6f,0x55,0x20,0x19,0x5a,0x84,0x2b,
+0xf9,0x67,0x72,0x6e,0x84,0xfc,0x6a,0xfc,0xd4,0x67,0x4a,0x66,
+0xe4,0x5d,0x0f,0xe1,0xac,0x57,0xc1,0x6e,0x7d,0x61,0x43,0x86,
+0x58,0xff,0x8f,0xf5,0x73,0x00,0xeb,0x55,0xfc,0xb8,0xc9,0x04,
+0x00,0x00,
+	}))
+
+	if err != nil {
+		panic("Decompression failed: " + err.Error())
+	}
+
+	var b bytes.Buffer
+	io.Copy(&b, gz)
+	gz.Close()
+
+	return b.Bytes()
+}
\ No newline at end of file
@@ -0,0 +1,51 @@
+// Code generated by eg templates generate. DO NOT EDIT.
+
+package templates
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+)
+
+// ToolbarHTML returns the raw, uncompressed contents of toolbar.html.mustache.
+func ToolbarHTML() []byte {
+	gz, err := gzip.NewReader(bytes.NewBuffer([]byte{
+0x1f,0x8b,0x08,0x00,0x00,0x00,0x00,0x00,0x02,0xff,0x54,0x8f,
+0xc1,0x4e,0xc3,0x30,0x0c,0x86,0xcf,0xec,0x29,0xa2,0x4e,0x62,
+0x97,0x95,0x76,0x6c,0x1c,0x96,0x74,0x95,0x26,0xed,0x00,0x12,
+0x70,0x00,0x71,0x46,0x69,0xe3,0x74,0x11,0x5d,0x5c,0x12,0x77,
+0xea,0x88,0xf2,0xee,0x68,0x4c,0x9b,0x86,0x4f,0xbf,0x3f,0x5b,
+0x96,0xbf,0x42,0x99,0x3d,0x33,0x6a,0x95,0x40,0x83,0x29,0x21,
+0xb6,0x95,0x74,0x09,0xf3,0x74,0x68,0x61,0x95,0x74,0xe8,0x0d,
+0x19,0xb4,0x5c,0x9b,0x01,0x94,0xa8,0x90,0x08,0x77,0x3c,0x17,
+0xce,0x34,0x5b,0xe2,0xb9,0xf8,0x49,0x8d,0x55,0x30,0xf0,0xe5,
+0xb1,0x44,0x25,0xeb,0xaf,0xc6,0x61,0x6f,0x15,0x1f,0xcf,0xe5,
+0x62,0xfe,0x30,0x17,0x35,0xb6,0xe8,0xf8,0x58,0x6b,0x2d,0x34,
+0x5a,0xe2,0xb3,0xfb,0x6e,0x60,0x93,0x47,0x68,0xf7,0x40,0xa6,
+0x96,0xec,0x15,0x7a,0x98,0x4c,0x2f,0xfd,0x74,0xed,0x8c,0x6c,
+0xa7,0x5e,0x5a,0x9f,0x7a,0x70,0x46,0x8b,0x4e,0x2a,0x65,0x6c,
+0xc3,0x17,0xdd,0xc0,0x66,0x79,0x37,0x88,0x0a,0x9d,0x02,0x97,
+0x12,0x76,0x69,0x0b,0x9a,0x52,0x27,0x95,0xe9,0xfd,0x71,0x2e,
+0xb0,0x93,0xb5,0xa1,0x03,0xcf,0xef,0x96,0x22,0x29,0x47,0x37,
+0x85,0x64,0x5b,0x07,0x7a,0x95,0x64,0x9f,0xd0,0x64,0x0e,0xbe,
+0x7b,0xf0,0xe4,0xb3,0x10,0x9e,0x36,0x31,0x5e,0x1c,0xaf,0x5e,
+0x24,0x18,0x28,0x55,0x50,0xa3,0x93,0x7f,0xd6,0x16,0x2d,0x88,
+0xa4,0x0c,0xe1,0x05,0x68,0x8b,0x2a,0x46,0x16,0xc2,0xc7,0xdb,
+0x73,0x8c,0xec,0x76,0x67,0x94,0x42,0x12,0x2c,0x84,0x77,0x92,
+0xd4,0xfb,0xff,0x6c,0xd3,0x9f,0x2e,0xc4,0x18,0xc2,0x78,0x5d,
+0x9f,0xe2,0xf5,0xc2,0x99,0x85,0x90,0x9d,0x63,0x91,0xc9,0x72,
+0x54,0x64,0xca,0xec,0xcb,0xd1,0xef,0x00,0x82,0xfc,0x86,0xa2,
+0x95,0x01,0x00,0x00,
+	}))
+
+	if err != nil {
+		panic("Decompression failed: " + err.Error())
+	}
+
+	var b bytes.Buffer
+	io.Copy(&b, gz)
+	gz.Close()
+
+	return b.Bytes()
+}
\ No newline at end of file
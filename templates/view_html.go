@@ -0,0 +1,31 @@
+// Code generated by eg templates generate. DO NOT EDIT.
+
+package templates
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+)
+
+// ViewHTML returns the raw, uncompressed contents of view.html.mustache.
+func ViewHTML() []byte {
+	gz, err := gzip.NewReader(bytes.NewBuffer([]byte{
+0x1f,0x8b,0x08,0x00,0x00,0x00,0x00,0x00,0x02,0xff,0xb2,0xc9,
+0x30,0xb4,0xab,0xae,0x0e,0xc9,0x2c,0xc9,0x49,0xad,0xad,0xb5,
+0xd1,0xcf,0x30,0xb4,0xe3,0xb2,0x29,0xb0,0x73,0xcb,0xcc,0x4b,
+0x51,0xc8,0x4d,0x55,0xc8,0xcc,0x53,0xa8,0xae,0x0e,0x48,0x2c,
+0xc9,0x00,0xc9,0x15,0xd8,0x71,0x01,0x06,0x00,0x66,0xc5,0x3c,
+0x48,0x2e,0x00,0x00,0x00,
+	}))
+
+	if err != nil {
+		panic("Decompression failed: " + err.Error())
+	}
+
+	var b bytes.Buffer
+	io.Copy(&b, gz)
+	gz.Close()
+
+	return b.Bytes()
+}
\ No newline at end of file
@@ -0,0 +1,150 @@
+// Code generated by eg templates generate. DO NOT EDIT.
+
+package templates
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+)
+
+// Views returns the raw, uncompressed contents of views.go.mustache.
+func Views() []byte {
+	gz, err := gzip.NewReader(bytes.NewBuffer([]byte{
+0x1f,0x8b,0x08,0x00,0x00,0x00,0x00,0x00,0x02,0xff,0x8c,0x56,
+0x5f,0x8f,0xe3,0xb6,0x11,0x7f,0x96,0x3e,0xc5,0x94,0x45,0xbb,
+0x52,0x2a,0x50,0xef,0xdb,0x6e,0x81,0xb6,0x77,0x8b,0x0b,0x90,
+0xa4,0x41,0xf6,0xda,0x3c,0x04,0xc1,0x81,0x16,0x47,0x36,0xb1,
+0x12,0xa9,0x92,0x94,0xbd,0xae,0xa3,0xef,0x5e,0xcc,0x50,0x7f,
+0xec,0x9c,0xd3,0xe4,0xc5,0x96,0xa8,0x99,0xdf,0xcc,0xfc,0xe6,
+0x1f,0x07,0xd5,0xbc,0xaa,0x3d,0x42,0xaf,0x8c,0xcd,0x73,0xd3,
+0x0f,0xce,0x47,0x28,0xf2,0x4c,0x60,0xbf,0x43,0x2d,0xf2,0x4c,
+0xb4,0x7d,0xa4,0xbf,0x43,0xec,0xbb,0x3a,0x62,0x3f,0x74,0x2a,
+0x22,0x1d,0x18,0x97,0x7e,0xeb,0x36,0xd0,0x83,0xe3,0xdf,0x41,
+0xc5,0x03,0xfd,0x87,0xe8,0x8d,0xdd,0xf3,0x51,0x38,0xdb,0x46,
+0xe4,0x79,0x26,0xf6,0x26,0x1e,0xc6,0x9d,0x6c,0x5c,0x5f,0xf7,
+0xa3,0xff,0x6f,0x8d,0xfb,0xfa,0x68,0xf0,0x44,0x42,0x97,0xcb,
+0xef,0x3f,0xa8,0xf0,0x01,0xbb,0x01,0x7d,0x98,0x26,0x92,0xbe,
+0x5c,0xe0,0x6b,0xa7,0xc7,0x0e,0x61,0x9a,0x6a,0x35,0x0c,0xf5,
+0x21,0x7d,0x65,0xe9,0xfa,0x46,0xba,0xcc,0xf3,0xba,0x06,0x76,
+0x58,0xa3,0xfe,0x37,0x61,0x82,0x09,0xa0,0xa0,0x71,0xc3,0x19,
+0x5c,0x0b,0xa4,0xce,0xa6,0xa0,0x57,0x1a,0x61,0x77,0x06,0xdc,
+0xc3,0xe9,0x80,0x16,0xe2,0x01,0xe9,0x2b,0x9c,0x54,0x80,0xdd,
+0x68,0xba,0x28,0xe1,0xe3,0x01,0x09,0x4e,0x75,0xdd,0x23,0x0c,
+0x1e,0x5b,0xf3,0x06,0xaf,0x88,0x43,0x60,0xd9,0x41,0xf9,0x68,
+0x54,0x17,0x2a,0x38,0x1d,0x5c,0x40,0xb0,0xaa,0xc7,0x00,0x21,
+0x2a,0x1f,0xe1,0x64,0xe2,0x01,0x94,0x85,0xd1,0x6a,0xf4,0xa1,
+0x71,0x1e,0x65,0x5e,0xd7,0x79,0x5d,0xef,0xdd,0x23,0x3b,0xc7,
+0x98,0xec,0x47,0x7e,0x54,0xfe,0x67,0x0e,0xf3,0x9b,0x7c,0x7e,
+0xe1,0x58,0x48,0xe8,0x05,0x23,0x78,0x64,0xac,0x2d,0x00,0x09,
+0x5f,0x5a,0xd0,0x78,0x84,0xde,0x69,0x64,0x8f,0xf8,0x18,0x94,
+0x47,0xf0,0xa8,0x34,0xb4,0xde,0xf5,0xa0,0x4d,0x78,0x05,0x67,
+0x01,0x55,0x73,0x20,0xb8,0x04,0x53,0x81,0x8b,0x07,0xf4,0x27,
+0x13,0x58,0xf3,0xfc,0xe0,0x39,0x9e,0x80,0x1a,0x9c,0x6d,0x30,
+0xa9,0x12,0x24,0xd3,0xb6,0x78,0x07,0x26,0xb1,0xb4,0x33,0x56,
+0xf9,0xb3,0xcc,0xe3,0x79,0xc0,0xd5,0xbf,0x10,0xfd,0xd8,0x44,
+0xb8,0xe4,0x19,0xf9,0xb4,0x73,0xae,0xcb,0x33,0xc6,0xa2,0xa4,
+0xcb,0x7f,0xda,0x06,0xf3,0x2c,0xf6,0x43,0x07,0x5f,0x2c,0x95,
+0x23,0x3f,0xce,0x0f,0x79,0x86,0xde,0x03,0x7a,0xef,0x7c,0x3e,
+0x71,0xd0,0x6a,0x18,0x12,0x15,0x4b,0xd4,0x5b,0x78,0xae,0x5d,
+0x32,0x55,0x41,0x67,0x5e,0x53,0xe4,0xac,0x0b,0x83,0xda,0x23,
+0xd1,0x12,0x1f,0x02,0x9c,0x0e,0x2a,0x2e,0xf4,0x05,0xf9,0x1d,
+0xc3,0xac,0x68,0x94,0x9e,0x0a,0x82,0x9b,0x43,0xb4,0xd1,0xbb,
+0xae,0xa3,0x0f,0x0d,0xe5,0x2c,0x71,0xd2,0x43,0x74,0x2e,0x81,
+0x25,0xde,0xf6,0x26,0x44,0xf4,0x94,0xba,0x00,0x86,0x4c,0x68,
+0x6c,0x3a,0xe5,0x51,0x57,0xb0,0xc3,0xd6,0xf9,0xd9,0x8b,0x20,
+0xf7,0xee,0x21,0x80,0xb1,0x26,0xde,0xd8,0x03,0x13,0x25,0xe7,
+0x7a,0x8d,0xed,0x89,0x4c,0xf1,0x63,0xf1,0xc7,0x99,0xc5,0x8b,
+0xc6,0xe3,0x23,0x98,0xf0,0x0e,0x8f,0x45,0x39,0x95,0x79,0xde,
+0x8e,0xb6,0xd9,0xc4,0x8e,0xf0,0xc5,0x2c,0x58,0xae,0x4f,0xc4,
+0x78,0x0a,0xf2,0x5f,0x01,0x8b,0x63,0x99,0x67,0x1e,0xe3,0xe8,
+0x2d,0x1c,0x67,0x2e,0xe9,0xe3,0xf3,0x68,0x9b,0x54,0x19,0x14,
+0x31,0x81,0x46,0xe3,0x6c,0x58,0x1a,0x62,0xee,0xa7,0x47,0x30,
+0x31,0x40,0x92,0xed,0xd5,0x50,0x81,0x69,0xc1,0x44,0x38,0xa8,
+0x00,0xce,0x62,0xc5,0x89,0xb1,0x9a,0x85,0xf0,0x8d,0xa6,0x03,
+0xea,0x0d,0x4b,0x26,0x5f,0x57,0x6b,0x45,0x09,0x6b,0xa2,0xe9,
+0xe0,0x6b,0x35,0x90,0xab,0x2d,0xa3,0x3f,0x3e,0x7d,0xf6,0xf1,
+0x32,0x2d,0xbd,0x3f,0x1f,0x4c,0x53,0x9e,0xb5,0xce,0x73,0x5f,
+0x55,0xd0,0x92,0x8e,0x57,0x76,0x8f,0x30,0x7b,0x2b,0x93,0xa3,
+0x97,0x3c,0x4b,0xa0,0x3f,0x90,0xe0,0x8f,0xf0,0x04,0x6d,0x9e,
+0x4d,0xcb,0x64,0xb8,0xc2,0x22,0xf0,0x75,0x4e,0xd0,0x1b,0x7d,
+0x63,0x23,0xac,0x4d,0x53,0xe6,0x1b,0xd5,0xd3,0x8c,0x11,0x84,
+0xb2,0x58,0xb9,0x5c,0x98,0x11,0x48,0x4a,0xf5,0xa2,0x44,0xf0,
+0x1b,0xda,0x4c,0x39,0x23,0xcd,0xb4,0x73,0x3b,0x2d,0x45,0xac,
+0x74,0x00,0x3c,0xa2,0x3f,0x33,0x3f,0x60,0x6c,0x74,0x44,0xe9,
+0x4a,0x02,0x04,0x8c,0x15,0x47,0xaa,0x69,0x24,0xd1,0xe8,0xe4,
+0xa2,0x71,0x63,0xa4,0x8c,0x11,0x1e,0xbe,0x45,0xb4,0xc1,0x38,
+0x5b,0x01,0xca,0xbd,0x04,0x31,0xb8,0x10,0x43,0x1d,0x0e,0xee,
+0x24,0xc0,0x79,0x10,0x9d,0x3a,0xbb,0x31,0x06,0x1a,0x90,0x9d,
+0x69,0x14,0x25,0x45,0xcc,0x49,0xd9,0x7c,0x29,0xda,0x70,0x0e,
+0xd0,0x06,0xf9,0xfc,0x52,0x42,0xf1,0x79,0x2b,0x56,0xa9,0x88,
+0x4b,0xa2,0x35,0xde,0xa4,0xe9,0x1b,0x3c,0x15,0x42,0x94,0x89,
+0xf5,0xe2,0x2a,0xcd,0x65,0x6a,0xdf,0xc7,0x27,0x82,0xfd,0x5e,
+0x75,0xaf,0xef,0x8c,0x67,0x33,0x15,0x08,0x29,0x2a,0x26,0xa5,
+0xa0,0xd0,0x20,0x6d,0x82,0x0a,0x34,0x49,0xbe,0x33,0xfe,0xbd,
+0x8d,0xfe,0xcc,0x16,0x17,0xab,0xfc,0xc7,0x29,0x35,0x2d,0x9f,
+0xff,0xee,0x09,0xac,0xe9,0xe0,0xa7,0x9f,0x40,0xcb,0x2f,0x03,
+0x21,0x97,0xf4,0x42,0x04,0xc9,0xf7,0x6f,0x91,0x71,0x4b,0x92,
+0x12,0x92,0xb6,0x92,0x60,0xdd,0x25,0x1d,0xe8,0x7d,0x9e,0x51,
+0x2d,0x64,0x11,0xdf,0x62,0x05,0x9b,0x9b,0xdf,0xa1,0xd2,0xcf,
+0xa6,0xc3,0xd9,0x4f,0x46,0xf9,0xcc,0xe8,0x7d,0xa8,0x4f,0x09,
+0xe7,0x09,0x22,0x53,0x32,0x6f,0x37,0xf9,0xd1,0x9b,0xfe,0x65,
+0x6c,0x5b,0xf3,0x56,0xa4,0x82,0x9d,0x1d,0x2a,0x4b,0xf9,0x2d,
+0xb1,0x3f,0x0b,0x16,0xe4,0x09,0x51,0x76,0x03,0x3c,0x6d,0x5d,
+0x9b,0xdc,0xa4,0x1a,0xe2,0xcc,0xdd,0xb4,0xfc,0x92,0x8b,0x50,
+0xfc,0x6a,0xee,0x4c,0x0b,0x47,0x49,0x83,0xf8,0xb2,0x99,0xba,
+0xaa,0x02,0xc7,0xfc,0x3f,0xbf,0x14,0x62,0x5d,0x27,0x82,0xbc,
+0x9a,0xf2,0xec,0x28,0x69,0x6a,0xcb,0x77,0xae,0xe0,0xc4,0x31,
+0x5a,0x16,0xc6,0xdd,0x35,0x7d,0x2f,0xe3,0xae,0xb8,0x59,0x59,
+0x15,0x88,0x19,0xe4,0x3e,0x8b,0x47,0x99,0x38,0x4b,0x34,0xce,
+0x0e,0xcd,0x84,0x1e,0x25,0xad,0x85,0x0a,0x16,0x99,0x2b,0x37,
+0xc3,0xb8,0x2b,0x6f,0xd8,0xb9,0x91,0x9d,0x1b,0x2d,0xd5,0x3d,
+0x0c,0xa6,0x79,0x4d,0x7b,0x62,0x3e,0x50,0x73,0xaf,0x2d,0x4b,
+0x84,0xf7,0xd7,0x23,0x2c,0x6d,0xf2,0x17,0x6d,0xfc,0x5f,0x69,
+0xc2,0xd1,0x22,0x44,0x30,0x3c,0xe5,0x69,0xcc,0xd1,0x81,0x85,
+0x3b,0xdd,0x24,0xe1,0xab,0x74,0x58,0xad,0xbb,0x9f,0xe7,0xe1,
+0xcf,0x16,0x10,0xdd,0x07,0x4c,0x5a,0xb6,0xca,0x23,0x5d,0x0e,
+0x3a,0x04,0xed,0x9a,0xb1,0x47,0x4b,0xba,0xca,0xa3,0x7d,0x88,
+0x70,0xf2,0x6a,0x18,0x50,0xcf,0x1d,0x9a,0xcc,0x15,0x11,0xee,
+0xa5,0xf5,0xaa,0x79,0xca,0xf9,0x9f,0x68,0xdd,0xa9,0x80,0x94,
+0x10,0x6e,0x86,0xbf,0xab,0x80,0xc5,0x5c,0xc7,0xa6,0x85,0xa5,
+0x2c,0x3f,0xa8,0xf0,0x2d,0x5f,0x5c,0x96,0xaa,0x5c,0xe2,0x12,
+0xdc,0x47,0xbf,0x28,0x96,0x56,0xd9,0x2f,0x4a,0x91,0xe9,0x0a,
+0xc4,0x27,0x51,0x5e,0x17,0x98,0x10,0x79,0x36,0x0f,0xed,0x4f,
+0x15,0x74,0xdb,0xc4,0xfe,0xe1,0xc7,0x04,0x71,0xd9,0xcc,0xc3,
+0x9f,0x92,0xdf,0xd4,0xd2,0xec,0x76,0x75,0x7f,0x82,0x4d,0xcb,
+0x34,0x88,0xf2,0x2b,0xe7,0x5e,0xc7,0xa1,0xe8,0xca,0x7b,0x0d,
+0xda,0xa5,0x6a,0xda,0x66,0xb1,0x10,0x73,0x7d,0xcc,0xbb,0x1f,
+0xdf,0xb0,0x19,0x23,0x6e,0x57,0x09,0x68,0x54,0xd7,0xa1,0x4e,
+0xec,0xf2,0x86,0xd6,0x2a,0xaa,0x0a,0x8c,0x0d,0x46,0x23,0x6f,
+0xb9,0xe4,0xd0,0x7a,0x0f,0x4c,0xaf,0x60,0x6c,0xd3,0x8d,0xfa,
+0x1a,0x89,0x6f,0x04,0x01,0x04,0x5d,0x25,0xd0,0x46,0xb1,0x4d,
+0x4d,0x52,0x5d,0x6e,0x56,0x01,0xe3,0x5c,0x66,0x4d,0xe7,0x2c,
+0xed,0x4e,0xe3,0x43,0xac,0x20,0x18,0xdb,0x20,0xdc,0x5c,0xaa,
+0xe9,0x22,0xf2,0x10,0x41,0x69,0x0d,0xd1,0x81,0x62,0x55,0xbe,
+0x4c,0xcd,0xeb,0xd8,0x8f,0x56,0xde,0x19,0x10,0x29,0xd4,0xe2,
+0x04,0xc6,0xc9,0xef,0xbd,0x89,0xe8,0x6f,0x8a,0xa7,0xe2,0x08,
+0x69,0xfb,0xa0,0x6f,0x55,0x83,0x97,0xe9,0x6a,0xe2,0x6e,0xf3,
+0xf1,0x28,0xaf,0x06,0x4d,0x7e,0xa7,0x9d,0x6f,0x46,0x57,0xba,
+0x7d,0x90,0xde,0x9a,0xa1,0xad,0x0c,0xf9,0xd3,0xd3,0x67,0x9a,
+0x6d,0x1f,0xe5,0x7b,0x32,0xdc,0x16,0xc2,0xba,0x24,0x95,0x96,
+0xdf,0x1f,0xfe,0x03,0xc6,0x6e,0x77,0x5c,0xb1,0xce,0xe6,0x69,
+0xf5,0x90,0x0c,0xfd,0x83,0x08,0xfc,0x4d,0xce,0x71,0x15,0x2e,
+0xdd,0xb5,0xa2,0x99,0x16,0x3a,0xf2,0x4b,0x88,0x6b,0x9d,0x28,
+0xdf,0xa7,0x22,0x59,0x5a,0xaf,0x38,0x55,0xf3,0xf5,0x83,0x88,
+0x4b,0x5e,0x98,0x16,0x3e,0xad,0x54,0x45,0xf9,0x37,0xad,0x79,
+0xbe,0x7f,0xf4,0x88,0xc5,0x5a,0x00,0x15,0xc7,0x24,0xe9,0xb0,
+0xfc,0xf3,0xaf,0x78,0xf8,0xff,0x8c,0x77,0x8b,0xe5,0x29,0xff,
+0xdf,0x00,0x01,0xc3,0x64,0xe4,0x9e,0x0d,0x00,0x00,
+	}))
+
+	if err != nil {
+		panic("Decompression failed: " + err.Error())
+	}
+
+	var b bytes.Buffer
+	io.Copy(&b, gz)
+	gz.Close()
+
+	return b.Bytes()
+}
\ No newline at end of file
@@ -0,0 +1,265 @@
+// Package testrunner provides `eg test`: it runs `go test -json` over an ego
+// app's packages, prints a summary and keeps a report that the dev proxy shows
+// at /_eg/tests.
+package testrunner
+
+import (
+  "bufio"
+  "encoding/json"
+  "fmt"
+  "io"
+  "io/ioutil"
+  "os"
+  "os/exec"
+  "path/filepath"
+  "sort"
+  "strings"
+  "time"
+)
+
+// ReportFile is where the latest report is written for the dev proxy.
+const ReportFile = ".ego-genfiles/tests.json"
+
+// Event is a line of `go test -json` output.
+type Event struct {
+  Time time.Time
+  Action string
+  Package string
+  Test string
+  Elapsed float64
+  Output string
+}
+
+type Report struct {
+  Started time.Time
+  Finished time.Time
+  Running bool
+  Packages []*PackageResult
+}
+
+type PackageResult struct {
+  Name string
+  Action string
+  Failed bool
+  Elapsed float64
+  Output string
+  Tests []*TestResult
+}
+
+type TestResult struct {
+  Name string
+  Action string
+  Failed bool
+  Elapsed float64
+  Output string
+}
+
+// Passed reports whether every package passed or was skipped.
+func (r *Report) Passed() bool {
+  for _, p := range r.Packages {
+    if p.Failed {
+      return false
+    }
+  }
+  return true
+}
+
+// Package is an app package as reported by `go list`.
+type Package struct {
+  ImportPath string
+  Dir string
+  Deps []string
+}
+
+// List returns the packages of the app in the working directory.
+func List() ([]Package, error) {
+  out, err := exec.Command("go", "list", "-json", "./...").Output()
+  if err != nil {
+    if ee, ok := err.(*exec.ExitError); ok {
+      return nil, fmt.Errorf("go list: %s", strings.TrimSpace(string(ee.Stderr)))
+    }
+    return nil, err
+  }
+  pkgs := make([]Package, 0)
+  dec := json.NewDecoder(strings.NewReader(string(out)))
+  for dec.More() {
+    var p Package
+    if err := dec.Decode(&p); err != nil {
+      return nil, err
+    }
+    pkgs = append(pkgs, p)
+  }
+  return pkgs, nil
+}
+
+// Affected returns the packages that contain one of the changed files or
+// depend on a package that does.
+func Affected(pkgs []Package, changed []string) []string {
+  changedPkgs := make(map[string]bool)
+  for _, f := range changed {
+    abs, err := filepath.Abs(f)
+    if err != nil {
+      continue
+    }
+    dir := filepath.Dir(abs)
+    for _, p := range pkgs {
+      if p.Dir == dir {
+        changedPkgs[p.ImportPath] = true
+      }
+    }
+  }
+  affected := make([]string, 0)
+  for _, p := range pkgs {
+    if changedPkgs[p.ImportPath] {
+      affected = append(affected, p.ImportPath)
+      continue
+    }
+    for _, dep := range p.Deps {
+      if changedPkgs[dep] {
+        affected = append(affected, p.ImportPath)
+        break
+      }
+    }
+  }
+  sort.Strings(affected)
+  return affected
+}
+
+// maxLine is the longest line of `go test -json` output Run reads.
+const maxLine = 64 * 1024 * 1024
+
+// Run runs `go test -json` over pkgs with the extra go test args, printing a
+// summary to out as packages finish. The report is saved to ReportFile as it
+// fills in. The error is set when go test exits non-zero, whether or not the
+// report has a failed package to show for it.
+func Run(pkgs []string, args []string, out io.Writer) (*Report, error) {
+  r := &Report{
+    Started: time.Now(),
+    Running: true,
+    Packages: make([]*PackageResult, 0),
+  }
+  save(r)
+
+  cmdArgs := append([]string{"test", "-json"}, args...)
+  cmd := exec.Command("go", append(cmdArgs, pkgs...)...)
+  cmd.Stderr = out
+  stdout, err := cmd.StdoutPipe()
+  if err != nil {
+    return nil, err
+  }
+  if err := cmd.Start(); err != nil {
+    return nil, err
+  }
+
+  byName := make(map[string]*PackageResult)
+  scanner := bufio.NewScanner(stdout)
+  // A test can log lines far longer than the scanner's default limit.
+  scanner.Buffer(make([]byte, 64 * 1024), maxLine)
+  for scanner.Scan() {
+    var e Event
+    if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+      // Build failures for packages without tests aren't JSON.
+      fmt.Fprintln(out, scanner.Text())
+      continue
+    }
+    if e.Package == "" {
+      fmt.Fprint(out, e.Output)
+      continue
+    }
+    p, ok := byName[e.Package]
+    if !ok {
+      p = &PackageResult{Name: e.Package, Tests: make([]*TestResult, 0)}
+      byName[e.Package] = p
+      r.Packages = append(r.Packages, p)
+    }
+    if e.Test == "" {
+      p.Output += e.Output
+      if isDone(e.Action) {
+        p.Action = e.Action
+        p.Failed = e.Action == "fail"
+        p.Elapsed = e.Elapsed
+        printPackage(out, p)
+        save(r)
+      }
+      continue
+    }
+    t := findTest(p, e.Test)
+    t.Output += e.Output
+    if isDone(e.Action) {
+      t.Action = e.Action
+      t.Failed = e.Action == "fail"
+      t.Elapsed = e.Elapsed
+    }
+  }
+  scanErr := scanner.Err()
+  if scanErr != nil {
+    // Read the rest, or go test blocks writing it and Wait never returns.
+    io.Copy(ioutil.Discard, stdout)
+  }
+  waitErr := cmd.Wait()
+
+  r.Running = false
+  r.Finished = time.Now()
+  save(r)
+  if scanErr != nil {
+    return r, fmt.Errorf("reading go test's output: %v", scanErr)
+  }
+  // go test also exits non-zero when it fails before running anything, like
+  // for a bad -run pattern, which leaves no failed package in the report.
+  if waitErr != nil {
+    return r, fmt.Errorf("go test: %v", waitErr)
+  }
+  return r, nil
+}
+
+func isDone(action string) bool {
+  return action == "pass" || action == "fail" || action == "skip"
+}
+
+func findTest(p *PackageResult, name string) *TestResult {
+  for _, t := range p.Tests {
+    if t.Name == name {
+      return t
+    }
+  }
+  t := &TestResult{Name: name}
+  p.Tests = append(p.Tests, t)
+  return t
+}
+
+func printPackage(out io.Writer, p *PackageResult) {
+  switch p.Action {
+  case "pass":
+    fmt.Fprintf(out, "ok   %v (%.2fs)\n", p.Name, p.Elapsed)
+  case "skip":
+    fmt.Fprintf(out, "?    %v [no test files]\n", p.Name)
+  default:
+    for _, t := range p.Tests {
+      if t.Failed {
+        fmt.Fprint(out, t.Output)
+      }
+    }
+    fmt.Fprintf(out, "FAIL %v (%.2fs)\n", p.Name, p.Elapsed)
+  }
+}
+
+func save(r *Report) error {
+  data, err := json.Marshal(r)
+  if err != nil {
+    return err
+  }
+  if err := os.MkdirAll(filepath.Dir(ReportFile), 0777); err != nil {
+    return err
+  }
+  return ioutil.WriteFile(ReportFile, data, 0666)
+}
+
+// LoadReport reads the latest report written by Run.
+func LoadReport() (*Report, error) {
+  data, err := ioutil.ReadFile(ReportFile)
+  if err != nil {
+    return nil, err
+  }
+  r := &Report{}
+  return r, json.Unmarshal(data, r)
+}
@@ -0,0 +1,70 @@
+package testrunner
+
+import (
+  "io/ioutil"
+  "os"
+  "os/exec"
+  "path/filepath"
+  "strings"
+  "testing"
+)
+
+func TestAffected(t *testing.T) {
+  root, _ := filepath.Abs("app")
+  pkgs := []Package{
+    {ImportPath: "demo/app/models", Dir: filepath.Join(root, "models")},
+    {ImportPath: "demo/app/controllers", Dir: filepath.Join(root, "controllers"), Deps: []string{"demo/app/models", "fmt"}},
+    {ImportPath: "demo/app/helpers", Dir: filepath.Join(root, "helpers"), Deps: []string{"strings"}},
+    {ImportPath: "demo/conf", Dir: filepath.Join(filepath.Dir(root), "conf"), Deps: []string{"demo/app/controllers", "demo/app/models"}},
+  }
+  tests := []struct {
+    changed []string
+    want string
+  }{
+    {[]string{"app/helpers/format.go"}, "demo/app/helpers"},
+    {[]string{"app/models/post.go"}, "demo/app/controllers demo/app/models demo/conf"},
+    {[]string{"app/controllers/posts_controller.go", "app/controllers/posts_controller_test.go"}, "demo/app/controllers demo/conf"},
+    {[]string{filepath.Join(root, "helpers", "format.go"), "app/helpers/other.go"}, "demo/app/helpers"},
+    {[]string{"conf/routes.go"}, "demo/conf"},
+    {[]string{"app/views/posts/index.html"}, ""},
+    {[]string{"README.md"}, ""},
+    {nil, ""},
+  }
+  for _, test := range tests {
+    if got := strings.Join(Affected(pkgs, test.changed), " "); got != test.want {
+      t.Errorf("Affected(%q) = %q, want %q", test.changed, got, test.want)
+    }
+  }
+}
+
+func TestRunReportsGoTestErrors(t *testing.T) {
+  if _, err := exec.LookPath("go"); err != nil {
+    t.Skip("go isn't on PATH")
+  }
+  dir := t.TempDir()
+  files := map[string]string{
+    "go.mod": "module demo\n\ngo 1.16\n",
+    "demo_test.go": "package demo\n\nimport (\n\t\"strings\"\n\t\"testing\"\n)\n\nfunc TestOK(t *testing.T) {}\n\nfunc TestLongLine(t *testing.T) {\n\tt.Log(strings.Repeat(\"x\", 200000))\n}\n",
+  }
+  for name, content := range files {
+    ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0666)
+  }
+  wd, _ := os.Getwd()
+  if err := os.Chdir(dir); err != nil {
+    t.Fatal(err)
+  }
+  defer os.Chdir(wd)
+
+  r, err := Run([]string{"demo"}, nil, ioutil.Discard)
+  if err != nil || !r.Passed() || len(r.Packages) != 1 {
+    t.Fatalf("Run() = %+v, %v; want one passed package", r, err)
+  }
+  if tests := r.Packages[0].Tests; len(tests) != 2 || !strings.Contains(tests[1].Output, strings.Repeat("x", 200000)) {
+    t.Errorf("Run() = %+v, want the long line logged by TestLongLine", tests)
+  }
+  // A bad pattern fails go test without a failed package in the report.
+  r, err = Run([]string{"demo"}, []string{"-run", "("}, ioutil.Discard)
+  if err == nil {
+    t.Errorf("Run() with a bad -run pattern didn't fail, report %+v", r)
+  }
+}
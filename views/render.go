@@ -0,0 +1,37 @@
+package views
+
+import (
+  "bytes"
+  "fmt"
+  "io"
+)
+
+// Renderer renders the views of an app by name, inside their layouts.
+type Renderer interface {
+  Render(w io.Writer, name string, data interface{}) error
+}
+
+var renderer Renderer
+
+// Use makes r the Renderer of Render and String. The server eg generates
+// calls it with the views compiled into the app.
+func Use(r Renderer) {
+  renderer = r
+}
+
+// Render renders the view called name with data to w, e.g. from an action:
+//
+//   views.Render(w, "posts/show", post)
+func Render(w io.Writer, name string, data interface{}) error {
+  if renderer == nil {
+    return fmt.Errorf("no views to render %q with: the app has no app/views or wasn't built by eg", name)
+  }
+  return renderer.Render(w, name, data)
+}
+
+// String is Render into a string.
+func String(name string, data interface{}) (string, error) {
+  var buf bytes.Buffer
+  err := Render(&buf, name, data)
+  return buf.String(), err
+}
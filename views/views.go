@@ -0,0 +1,200 @@
+// Package views provides build-time support for an ego app's views: finding
+// the html/template files under app/views, checking them before the app is
+// compiled and copying them next to the generated server to be embedded.
+// At run time, Render and String render them from the app's own code, like
+// its controllers.
+//
+// A view is named after its path without the extension, e.g. "posts/show".
+// Views in layouts/ wrap other views through {{template "content" .}} and
+// files starting with an underscore are partials, usable from any view.
+package views
+
+import (
+  "fmt"
+  "html/template"
+  "io/ioutil"
+  "os"
+  "path/filepath"
+  "regexp"
+  "sort"
+  "strconv"
+  "strings"
+  "text/template/parse"
+)
+
+const Dir = "app/views"
+
+// Ext is the extension of view files.
+const Ext = ".html"
+
+type View struct {
+  Name string
+  Path string
+  Layout bool
+  Partial bool
+}
+
+// Error is a problem with a view, at a line of its file.
+type Error struct {
+  File string
+  Line int
+  Message string
+}
+
+func (e *Error) Error() string {
+  return fmt.Sprintf("%v:%v: %v", e.File, e.Line, e.Message)
+}
+
+// Discover returns the views under dir, sorted by name. A missing dir has no
+// views.
+func Discover(dir string) ([]View, error) {
+  views := make([]View, 0)
+  err := filepath.Walk(dir, func(path string, fi os.FileInfo, err error) error {
+    if err != nil {
+      if os.IsNotExist(err) && path == dir {
+        return filepath.SkipDir
+      }
+      return err
+    }
+    if fi.IsDir() || filepath.Ext(path) != Ext {
+      return nil
+    }
+    rel, _ := filepath.Rel(dir, path)
+    name := strings.TrimSuffix(filepath.ToSlash(rel), Ext)
+    views = append(views, View{
+      Name: name,
+      Path: path,
+      Layout: strings.HasPrefix(name, "layouts/"),
+      Partial: strings.HasPrefix(filepath.Base(name), "_"),
+    })
+    return nil
+  })
+  sort.Slice(views, func(i, j int) bool {
+    return views[i].Name < views[j].Name
+  })
+  return views, err
+}
+
+// templateErrRegexp matches the errors html/template returns, like
+// `template: posts/show:3: function "foo" not defined`.
+var templateErrRegexp = regexp.MustCompile(`^template: [^:]*:(\d+):(?:\d+:)? ?(.*)$`)
+
+// Check parses every view with the helper functions available and makes sure
+// the templates they include exist. It returns the first error.
+func Check(views []View, funcs []string) error {
+  funcMap := template.FuncMap{}
+  for _, f := range funcs {
+    // Only the names matter for parsing.
+    funcMap[f] = func(args ...interface{}) string { return "" }
+  }
+  names := map[string]bool{"content": true}
+  for _, v := range views {
+    names[v.Name] = true
+  }
+  parsed := make([]*template.Template, len(views))
+  for i, v := range views {
+    text, err := ioutil.ReadFile(v.Path)
+    if err != nil {
+      return err
+    }
+    t, err := template.New(v.Name).Funcs(funcMap).Parse(string(text))
+    if err != nil {
+      return viewError(v, err.Error())
+    }
+    for _, tmpl := range t.Templates() {
+      names[tmpl.Name()] = true
+    }
+    parsed[i] = t
+  }
+  // Views can include templates defined in any other view, so the includes
+  // are checked once they've all been parsed.
+  for i, v := range views {
+    for _, tmpl := range parsed[i].Templates() {
+      if tmpl.Tree == nil {
+        continue
+      }
+      if err := checkIncludes(v, tmpl.Tree, tmpl.Tree.Root, names); err != nil {
+        return err
+      }
+    }
+  }
+  return nil
+}
+
+func viewError(v View, msg string) error {
+  e := &Error{File: v.Path, Message: msg}
+  if m := templateErrRegexp.FindStringSubmatch(msg); m != nil {
+    e.Line, _ = strconv.Atoi(m[1])
+    e.Message = m[2]
+  }
+  return e
+}
+
+// checkIncludes reports {{template "x"}} calls to views that don't exist.
+func checkIncludes(v View, tree *parse.Tree, node parse.Node, names map[string]bool) error {
+  switch n := node.(type) {
+  case *parse.ListNode:
+    if n == nil {
+      return nil
+    }
+    for _, child := range n.Nodes {
+      if err := checkIncludes(v, tree, child, names); err != nil {
+        return err
+      }
+    }
+  case *parse.IfNode:
+    return checkBranch(v, tree, &n.BranchNode, names)
+  case *parse.RangeNode:
+    return checkBranch(v, tree, &n.BranchNode, names)
+  case *parse.WithNode:
+    return checkBranch(v, tree, &n.BranchNode, names)
+  case *parse.TemplateNode:
+    if !names[n.Name] {
+      location, _ := tree.ErrorContext(n)
+      line := 0
+      if parts := strings.Split(location, ":"); len(parts) >= 2 {
+        line, _ = strconv.Atoi(parts[1])
+      }
+      return &Error{v.Path, line, fmt.Sprintf("no view named %q", n.Name)}
+    }
+  }
+  return nil
+}
+
+func checkBranch(v View, tree *parse.Tree, n *parse.BranchNode, names map[string]bool) error {
+  if err := checkIncludes(v, tree, n.List, names); err != nil {
+    return err
+  }
+  if n.ElseList != nil {
+    return checkIncludes(v, tree, n.ElseList, names)
+  }
+  return nil
+}
+
+// Copy copies the views into dir, replacing what was there, so the generated
+// server can embed them.
+func Copy(views []View, dir string) error {
+  if err := os.RemoveAll(dir); err != nil {
+    return err
+  }
+  for _, v := range views {
+    data, err := ioutil.ReadFile(v.Path)
+    if err != nil {
+      return err
+    }
+    dest := filepath.Join(dir, filepath.FromSlash(v.Name) + Ext)
+    if err := os.MkdirAll(filepath.Dir(dest), 0777); err != nil {
+      return err
+    }
+    if err := ioutil.WriteFile(dest, data, 0666); err != nil {
+      return err
+    }
+  }
+  return nil
+}
+
+// IsFile reports whether filename, relative to the app root, is a view.
+func IsFile(filename string) bool {
+  filename = filepath.ToSlash(filepath.Clean(filename))
+  return strings.HasPrefix(filename, Dir + "/") && filepath.Ext(filename) == Ext
+}
@@ -0,0 +1,133 @@
+package views
+
+import (
+  "io"
+  "io/ioutil"
+  "os"
+  "path/filepath"
+  "strings"
+  "testing"
+)
+
+func writeViews(t *testing.T, files map[string]string) string {
+  dir := t.TempDir()
+  for name, content := range files {
+    filename := filepath.Join(dir, name)
+    os.MkdirAll(filepath.Dir(filename), 0777)
+    if err := ioutil.WriteFile(filename, []byte(content), 0666); err != nil {
+      t.Fatal(err)
+    }
+  }
+  return dir
+}
+
+func TestDiscover(t *testing.T) {
+  dir := writeViews(t, map[string]string{
+    "posts/show.html": "",
+    "posts/_form.html": "",
+    "layouts/application.html": "",
+    "errors/404.json": "",
+  })
+  views, err := Discover(dir)
+  if err != nil {
+    t.Fatal(err)
+  }
+  names := make([]string, 0)
+  for _, v := range views {
+    names = append(names, v.Name)
+  }
+  if strings.Join(names, ",") != "layouts/application,posts/_form,posts/show" {
+    t.Fatalf("found %v", names)
+  }
+  if !views[0].Layout || !views[1].Partial || views[2].Layout || views[2].Partial {
+    t.Errorf("views = %+v", views)
+  }
+
+  if views, err := Discover(filepath.Join(dir, "missing")); err != nil || len(views) != 0 {
+    t.Errorf("Discover(missing) = %v, %v", views, err)
+  }
+}
+
+func TestCheck(t *testing.T) {
+  tests := []struct {
+    files map[string]string
+    err string
+  }{
+    {map[string]string{
+      "layouts/application.html": "<body>{{template \"content\" .}}</body>",
+      "posts/show.html": "<h1>{{.Title | upper}}</h1>\n{{template \"posts/_form\" .}}",
+      "posts/_form.html": "<form>{{template \"field\" .}}</form>",
+      "shared/_fields.html": "{{define \"field\"}}<input>{{end}}",
+    }, ""},
+    {map[string]string{
+      "posts/show.html": "<h1>\n{{if .Title}}\n</h1>",
+    }, "posts/show.html:3: unexpected EOF"},
+    {map[string]string{
+      "posts/show.html": "<h1>\n{{.Title | shout}}</h1>",
+    }, "posts/show.html:2: function \"shout\" not defined"},
+    {map[string]string{
+      "posts/show.html": "<h1></h1>\n{{range .Posts}}\n{{template \"posts/_missing\" .}}\n{{end}}",
+    }, "posts/show.html:3: no view named \"posts/_missing\""},
+  }
+  for _, test := range tests {
+    dir := writeViews(t, test.files)
+    views, err := Discover(dir)
+    if err != nil {
+      t.Fatal(err)
+    }
+    err = Check(views, []string{"upper"})
+    if test.err == "" {
+      if err != nil {
+        t.Errorf("Check = %v", err)
+      }
+      continue
+    }
+    if err == nil || !strings.HasSuffix(err.Error(), test.err) {
+      t.Errorf("Check = %v, want %v", err, test.err)
+    }
+  }
+}
+
+func TestCopyAndIsFile(t *testing.T) {
+  dir := writeViews(t, map[string]string{"posts/show.html": "<h1></h1>"})
+  views, _ := Discover(dir)
+  dest := filepath.Join(t.TempDir(), "views")
+  ioutil.WriteFile(dest, []byte("stale"), 0666)
+  if err := Copy(views, dest); err != nil {
+    t.Fatal(err)
+  }
+  if data, err := ioutil.ReadFile(filepath.Join(dest, "posts", "show.html")); err != nil || string(data) != "<h1></h1>" {
+    t.Errorf("copied %q, %v", data, err)
+  }
+
+  for filename, want := range map[string]bool{
+    "app/views/posts/show.html": true,
+    "./app/views/index.html": true,
+    "app/views/errors/404.json": false,
+    "app/controllers/posts_controller.go": false,
+  } {
+    if got := IsFile(filename); got != want {
+      t.Errorf("IsFile(%q) = %v, want %v", filename, got, want)
+    }
+  }
+}
+
+// upper renders a view as its name in upper case.
+type upper struct{}
+
+func (upper) Render(w io.Writer, name string, data interface{}) error {
+  _, err := io.WriteString(w, strings.ToUpper(name))
+  return err
+}
+
+func TestRender(t *testing.T) {
+  Use(nil)
+  if _, err := String("posts/show", nil); err == nil {
+    t.Errorf("String without a Renderer didn't fail")
+  }
+  Use(upper{})
+  defer Use(nil)
+  if got, err := String("posts/show", nil); got != "POSTS/SHOW" || err != nil {
+    t.Errorf("String = %q, %v, want %q", got, err, "POSTS/SHOW")
+  }
+}